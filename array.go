@@ -45,7 +45,7 @@ func (rule *indexRule) Validate(validator *Validator, value interface{}) {
 
 	idxValue := val.Index(rule.idx)
 	if idxValue.IsValid() && idxValue.CanInterface() {
-		validator.DiveIndex(rule.idx, func(v *Validator) {
+		validator.dive(validator.loc.IndexLocation(rule.idx), &valueNode{ref: idxValue}, func(v *Validator) {
 			And(rule.rules...).Validate(v, idxValue.Interface())
 		})
 	} else {
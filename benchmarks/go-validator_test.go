@@ -23,3 +23,25 @@ func BenchmarkGoPlayground_SimpleStruct(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkGoPlayground_NestedStruct(b *testing.B) {
+	type Item struct {
+		Name  string `json:"name" validate:"min=1,max=20"`
+		Price int    `json:"price" validate:"gte=0"`
+	}
+	type Order struct {
+		ID    string `json:"id" validate:"min=1,max=20"`
+		Items []Item `json:"items" validate:"min=1,dive"`
+	}
+
+	validate := validator.New()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := validate.Struct(&Order{Items: []Item{{}, {}}}); err == nil {
+			panic("invalid results")
+		}
+		if err := validate.Struct(&Order{ID: "1", Items: []Item{{Name: "apple", Price: 100}, {Name: "orange", Price: 120}}}); err != nil {
+			panic("invalid results")
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/soranoba/valis"
 	"github.com/soranoba/valis/is"
 	"github.com/soranoba/valis/tagrule"
+	"github.com/soranoba/valis/when"
 )
 
 func BenchmarkValis_SimpleStruct_tag(b *testing.B) {
@@ -61,3 +62,33 @@ func BenchmarkValis_SimpleStruct_field(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkValis_NestedStruct_tag(b *testing.B) {
+	type Item struct {
+		Name  string `json:"name" validate:"min=1,max=20"`
+		Price int    `json:"price" validate:"gte=0"`
+	}
+	type Order struct {
+		ID    string `json:"id" validate:"min=1,max=20"`
+		Items []Item `json:"items" validate:"min=1"`
+	}
+
+	v := valis.NewValidator()
+	v.SetCommonRules(
+		when.IsStruct(valis.EachFields(tagrule.Validate)).
+			ElseWhen(when.IsSliceOrArray(valis.Each( /* only common rules */ ))),
+	)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		o := &Order{Items: []Item{{}, {}}}
+		if err := v.Validate(o); err == nil {
+			panic("invalid results")
+		}
+
+		o = &Order{ID: "1", Items: []Item{{Name: "apple", Price: 100}, {Name: "orange", Price: 120}}}
+		if err := v.Validate(o); err != nil {
+			panic("invalid results")
+		}
+	}
+}
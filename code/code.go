@@ -9,13 +9,19 @@ const (
 	NotArray       = "not_array"
 	NotMap         = "not_map"
 	NotNumeric     = "not_numeric"
+	NotInteger     = "not_integer"
+	NotBoolean     = "not_boolean"
+	NotNull        = "not_null"
 	NotIterable    = "not_iterable"
+	NotTime        = "not_time"
+	NotDuration    = "not_duration"
 	NotAssignable  = "not_assignable" // %[1]s = TypeName
 )
 
 // Not found error codes.
 const (
 	NoKey      = "no_key"       // %[1]v = Key
+	NoField    = "no_field"     // %[1]s = FieldName
 	OutOfRange = "out_of_range" // %[1]d = Index, %[2]d = Length
 )
 
@@ -24,6 +30,13 @@ const (
 	ConversionFailed = "conversion" // %[1]w = Error
 )
 
+// Interruption error codes.
+const (
+	Canceled          = "canceled"  // %[1]w = Error
+	MaxDepthExceeded  = "max_depth" // %[1]d = MaxDepth
+	CircularReference = "circular_reference"
+)
+
 // Validation error codes.
 const (
 	Custom             = "custom" // %[1]w = Error
@@ -46,3 +59,61 @@ const (
 	InvalidScheme      = "invalid_scheme"   // %[1]v = List
 	InvalidEmailFormat = "invalid_email"
 )
+
+// Format validation error codes.
+const (
+	InvalidUUIDFormat     = "invalid_uuid"
+	InvalidIPFormat       = "invalid_ip"
+	InvalidIPv4Format     = "invalid_ipv4"
+	InvalidIPv6Format     = "invalid_ipv6"
+	InvalidCIDRFormat     = "invalid_cidr"
+	InvalidMACFormat      = "invalid_mac"
+	InvalidHostnameFormat = "invalid_hostname"
+	InvalidFQDNFormat     = "invalid_fqdn"
+	InvalidSemverFormat   = "invalid_semver"
+	InvalidBase64Format   = "invalid_base64"
+	InvalidHexColorFormat = "invalid_hex_color"
+	InvalidCountryCode    = "invalid_country_code"
+	InvalidCurrencyCode   = "invalid_currency_code"
+	InvalidLanguageTag    = "invalid_language_tag"
+)
+
+// String content validation error codes.
+const (
+	ASCIIOnly              = "ascii_only"
+	PrintableOnly          = "printable_only"
+	AlphanumericOnly       = "alphanumeric_only"
+	NoControlCharacters    = "no_control_characters"
+	NotNormalized          = "not_normalized"            // %[1]s = Form
+	TooLongGraphemeLength  = "too_long_grapheme_length"  // %[1]d = Count
+	TooShortGraphemeLength = "too_short_grapheme_length" // %[1]d = Count
+)
+
+// Cross-field validation error codes.
+const (
+	EqualField       = "eqfield"          // %[1]s = FieldName
+	NotEqualField    = "nefield"          // %[1]s = FieldName
+	GreaterThanField = "gtfield"          // %[1]s = FieldName
+	LessThanField    = "ltfield"          // %[1]s = FieldName
+	RequiredIf       = "required_if"      // %[1]s = FieldName, %[2]s = FieldValue
+	RequiredWith     = "required_with"    // %[1]s = FieldName
+	RequiredWithout  = "required_without" // %[1]s = FieldName
+	ExcludedIf       = "excluded_if"      // %[1]s = FieldName, %[2]s = FieldValue
+)
+
+// Time validation error codes.
+const (
+	Before             = "before"            // %[1]v = Time
+	After              = "after"             // %[1]v = Time
+	TimeOutOfRange     = "time_out_of_range" // %[1]v = Min, %[2]v = Max
+	Future             = "future"
+	Past               = "past"
+	Weekday            = "weekday"               // %[1]v = List
+	DurationOutOfRange = "duration_out_of_range" // %[1]v = Min, %[2]v = Max
+)
+
+// Warning codes.
+// They are usually used with valis.NewWarning.
+const (
+	Deprecated = "deprecated"
+)
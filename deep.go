@@ -0,0 +1,107 @@
+package valis
+
+import "reflect"
+
+type (
+	// DeepRule is a rule that verifies all fields in the value recursively. See Deep.
+	DeepRule struct {
+		rules *fieldRules
+	}
+)
+
+// Deep returns a new rule that recursively walks structs, pointers, slices, arrays, maps and interfaces,
+// and verifies all field values of the structs meet the rules and all common rules.
+// The elements of slices, arrays and maps are verified with only common rules, like Each and EachValues.
+//
+// It replaces the common rules that descend to all hierarchies, for example:
+//
+//	when.IsStruct(valis.EachFields(rules...)).
+//		ElseWhen(when.IsSliceOrArray(valis.Each())).
+//		ElseWhen(when.IsMap(valis.EachValues()))
+//
+// Do not use it as a common rule, because it descends by itself.
+// It does not descend to the pointer that is already being walked (e.g. a self-referential pointer),
+// and it does not descend deeper than the max depth of the Validator, in the same way as the common rules.
+// See also Validator.SetMaxDepth.
+//
+// The self-referential pointers are reported as errors of code.CircularReference,
+// and the locations deeper than the max depth are reported as errors of code.MaxDepthExceeded.
+func Deep(rules ...Rule) *DeepRule {
+	return &DeepRule{rules: newFieldRules(rules)}
+}
+
+// See Rule.Validate
+func (r *DeepRule) Validate(validator *Validator, value interface{}) {
+	r.walk(validator, value)
+}
+
+// walk verifies the fields in the value, and descends to the values of them.
+// NOTE: the circular references and the max depth are checked by Validator.dive.
+func (r *DeepRule) walk(validator *Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		plan := loadStructPlan(val.Type())
+		for _, fieldPlan := range plan.fields {
+			if validator.isStopped() {
+				return
+			}
+			// NOTE: it does not dive into the field when no rules are applied and there are no values to descend.
+			if len(validator.commonRules) == 0 && !r.rules.hasRules(fieldPlan) && !isDeepKind(fieldPlan.field.Type.Kind()) {
+				continue
+			}
+			fieldPlan := fieldPlan
+			fieldVal, ok := fieldPlan.value(val)
+			if !ok {
+				continue
+			}
+			validator.dive(fieldPlan.location(validator.loc), &valueNode{ref: fieldVal, embedded: fieldPlan.embedded}, func(v *Validator) {
+				r.rules.validate(v, fieldPlan, fieldVal.Interface())
+				// NOTE: the fields of the embedded struct are walked as the fields of the struct.
+				if !fieldPlan.embedded {
+					// NOTE: the value may be transformed by the rules.
+					r.walk(v, v.node.value)
+				}
+			})
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if validator.isStopped() {
+				return
+			}
+			indexVal := val.Index(i)
+			validator.dive(validator.loc.IndexLocation(i), &valueNode{ref: indexVal}, func(v *Validator) {
+				And().Validate(v, indexVal.Interface())
+				r.walk(v, v.node.value)
+			})
+		}
+	case reflect.Map:
+		iter := val.MapRange()
+		for iter.Next() {
+			if validator.isStopped() {
+				return
+			}
+			keyVal := iter.Key()
+			validator.dive(validator.loc.MapValueLocation(keyVal.Interface()), &valueNode{ref: val, key: keyVal}, func(v *Validator) {
+				And().Validate(v, iter.Value().Interface())
+				r.walk(v, v.node.value)
+			})
+		}
+	}
+}
+
+// isDeepKind returns true, when the value of the kind may have the values to descend.
+func isDeepKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Interface, reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package valis
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/soranoba/valis/translations"
 	"golang.org/x/text/language"
@@ -18,33 +19,89 @@ type (
 
 	Error = *errorDetail
 
+	// Severity is the severity of the Error.
+	// The Validator returns an error only when there is an Error of SeverityError.
+	Severity int
+
 	LocationError struct {
 		Error
 		Location *Location
 	}
 
+	// ErrorEntry is a JSON representation of the LocationError.
+	ErrorEntry struct {
+		// Pointer is a JSON Pointer (RFC 6901) that indicates the location. See JSONPointerLocationNameResolver.
+		Pointer string        `json:"pointer"`
+		Code    string        `json:"code"`
+		Message string        `json:"message"`
+		Params  []interface{} `json:"params,omitempty"`
+		// Severity is omitted, when it is SeverityError.
+		Severity Severity `json:"severity,omitempty"`
+	}
+
 	// ErrorCollector is an interface that receives some Error of each rule and creates the error returned by Validator.Validate.
 	ErrorCollector interface {
 		HasError() bool
 		Add(loc *Location, err Error)
 		MakeError() error
 	}
+	// LimitedErrorCollector is an ErrorCollector that can refuse to collect more errors.
+	// When the ErrorCollector implements it, the rules stop traversal after IsFull returns true.
+	LimitedErrorCollector interface {
+		ErrorCollector
+		IsFull() bool
+	}
+	// WarningCollector is an ErrorCollector that can create the error including only warnings.
+	// HasError and MakeError of the ErrorCollector should ignore the warnings, when there is no Error of SeverityError.
+	WarningCollector interface {
+		ErrorCollector
+		HasWarning() bool
+		MakeWarning() error
+	}
 	ErrorCollectorFactoryFunc func() ErrorCollector
 )
 
+const (
+	// SeverityError is a severity of the Error that fails the validation.
+	SeverityError Severity = iota
+	// SeverityWarning is a severity of the Error that does not fail the validation (e.g. deprecation).
+	SeverityWarning
+)
+
 type (
 	standardErrorCollector struct {
 		nameResolver LocationNameResolver
 		errors       []*LocationError
 	}
+	// recordingErrorCollector is an ErrorCollector that records the errors added to the ErrorCollector.
+	// It is used to move the errors to another ErrorCollector (e.g. Or, ParallelEach).
+	recordingErrorCollector struct {
+		ErrorCollector
+		errors []*LocationError
+	}
+	limitedErrorCollector struct {
+		ErrorCollector
+		max   int
+		count int
+	}
 	errorDetail struct {
 		code                  string
 		params                []interface{}
 		value                 interface{}
 		valueBeforeConversion interface{}
+		severity              Severity
+		message               string
+		// literal is true, when the message is not a format. See WithLiteralMessage.
+		literal bool
 	}
 )
 
+const (
+	// translationNotFound is the fallback message of the label and the literal message,
+	// which means that the translation of them does not exist.
+	translationNotFound = "\x00"
+)
+
 var (
 	enCatalogOnce sync.Once
 	enCatalog     *translations.Catalog
@@ -59,31 +116,126 @@ func (e *ValidationError) Details() []*LocationError {
 	return e.errors
 }
 
+// Errors returns the details of SeverityError.
+func (e *ValidationError) Errors() []*LocationError {
+	return e.filter(SeverityError)
+}
+
+// Warnings returns the details of SeverityWarning.
+func (e *ValidationError) Warnings() []*LocationError {
+	return e.filter(SeverityWarning)
+}
+
+func (e *ValidationError) filter(severity Severity) []*LocationError {
+	errors := make([]*LocationError, 0, len(e.errors))
+	for _, locErr := range e.errors {
+		if locErr.Severity() == severity {
+			errors = append(errors, locErr)
+		}
+	}
+	return errors
+}
+
 func (e *ValidationError) Translate(p *message.Printer) map[string][]string {
+	return e.translate(p, e.errors)
+}
+
+// TranslateWithLabels is equiv to Translate, but the messages include the label of the field.
+// The label is translated by the printer with the key of translations.LabelKey, and it is used as is when the translation does not exist.
+// The label is combined with the message by the translations.LabelTemplateKey.
+// When the field does not have the label, the message does not include it. See also Location.Label.
+func (e *ValidationError) TranslateWithLabels(p *message.Printer) map[string][]string {
 	trans := make(map[string][]string)
 	for _, locErr := range e.errors {
 		key := e.nameResolver.ResolveLocationName(locErr.Location)
-		trans[key] = append(trans[key], p.Sprintf(locErr.Error.Code(), locErr.Error.Params()...))
+		msg := locErr.Error.sprint(p)
+		if label, ok := locErr.Location.Label(); ok {
+			// NOTE: the label is not a format string, so it is used as is when the translation does not exist.
+			if translated := p.Sprintf(message.Key(translations.LabelKey(label), translationNotFound)); translated != translationNotFound {
+				label = translated
+			}
+			msg = p.Sprintf(translations.LabelTemplateKey, label, msg)
+		}
+		trans[key] = append(trans[key], msg)
+	}
+	return trans
+}
+
+// TranslateAcceptLanguage is equiv to Translate, but it translates into the language of the catalog
+// that best matches the value of the Accept-Language header. See also translations.Catalog.MatchAcceptLanguage.
+func (e *ValidationError) TranslateAcceptLanguage(c *translations.Catalog, acceptLanguage string) map[string][]string {
+	return e.Translate(c.NewAcceptLanguagePrinter(acceptLanguage))
+}
+
+// TranslateBySeverity is equiv to Translate, but the messages are grouped by the severity.
+func (e *ValidationError) TranslateBySeverity(p *message.Printer) map[Severity]map[string][]string {
+	trans := make(map[Severity]map[string][]string)
+	for _, severity := range [...]Severity{SeverityError, SeverityWarning} {
+		if errors := e.filter(severity); len(errors) > 0 {
+			trans[severity] = e.translate(p, errors)
+		}
+	}
+	return trans
+}
+
+func (e *ValidationError) translate(p *message.Printer, errors []*LocationError) map[string][]string {
+	trans := make(map[string][]string)
+	for _, locErr := range errors {
+		key := e.nameResolver.ResolveLocationName(locErr.Location)
+		trans[key] = append(trans[key], locErr.Error.sprint(p))
 	}
 	return trans
 }
 
+// Entries returns the ErrorEntry of each error translated by the printer.
+// When p is nil, it is translated into English.
+func (e *ValidationError) Entries(p *message.Printer) []*ErrorEntry {
+	if p == nil {
+		p = englishPrinter()
+	}
+
+	entries := make([]*ErrorEntry, 0, len(e.errors))
+	for _, locErr := range e.errors {
+		params := make([]interface{}, len(locErr.Error.Params()))
+		for i, param := range locErr.Error.Params() {
+			// NOTE: most errors are encoded to an empty object, so it uses the message instead.
+			if err, ok := param.(error); ok {
+				param = err.Error()
+			}
+			params[i] = param
+		}
+		entries = append(entries, &ErrorEntry{
+			Pointer:  JSONPointerLocationNameResolver.ResolveLocationName(locErr.Location),
+			Code:     locErr.Error.Code(),
+			Message:  locErr.Error.sprint(p),
+			Params:   params,
+			Severity: locErr.Error.Severity(),
+		})
+	}
+	return entries
+}
+
+// MarshalJSON encodes the Entries translated into English.
+// See also problem sub-package.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Entries(nil))
+}
+
 func (e *ValidationError) Error() string {
-	enCatalogOnce.Do(func() {
-		enCatalog = translations.NewCatalog()
-		enCatalog.Set(translations.DefaultEnglish)
-	})
-	p := message.NewPrinter(language.English, message.Catalog(enCatalog))
+	p := englishPrinter()
 
 	buf := bytes.NewBuffer(nil)
 	for i, locErr := range e.errors {
 		name := e.nameResolver.ResolveLocationName(locErr.Location)
 		buf.WriteString(fmt.Sprintf("(%s) ", locErr.Code()))
+		if locErr.Severity() == SeverityWarning {
+			buf.WriteString("[warning] ")
+		}
 		if name != "" {
 			buf.WriteString(name)
 			buf.WriteString(" ")
 		}
-		buf.WriteString(p.Sprintf(locErr.Error.Code(), locErr.Error.Params()...))
+		buf.WriteString(locErr.Error.sprint(p))
 		if len(e.errors)-1 != i {
 			buf.WriteString("\n")
 		}
@@ -91,6 +243,15 @@ func (e *ValidationError) Error() string {
 	return buf.String()
 }
 
+// englishPrinter returns a message.Printer with the English catalog.
+func englishPrinter() *message.Printer {
+	enCatalogOnce.Do(func() {
+		enCatalog = translations.NewCatalog()
+		enCatalog.Set(translations.DefaultEnglish)
+	})
+	return message.NewPrinter(language.English, message.Catalog(enCatalog))
+}
+
 // NewStandardErrorCollector returns an ErrorCollector used by default.
 func NewStandardErrorCollector(nameResolver LocationNameResolver) ErrorCollector {
 	return &standardErrorCollector{
@@ -100,7 +261,22 @@ func NewStandardErrorCollector(nameResolver LocationNameResolver) ErrorCollector
 }
 
 func (c *standardErrorCollector) HasError() bool {
-	return len(c.errors) > 0
+	return c.count(SeverityError) > 0
+}
+
+func (c *standardErrorCollector) HasWarning() bool {
+	return c.count(SeverityWarning) > 0
+}
+
+// count returns the number of errors of the severity.
+func (c *standardErrorCollector) count(severity Severity) int {
+	n := 0
+	for _, locErr := range c.errors {
+		if locErr.Severity() == severity {
+			n++
+		}
+	}
+	return n
 }
 
 func (c *standardErrorCollector) Add(loc *Location, err Error) {
@@ -110,6 +286,7 @@ func (c *standardErrorCollector) Add(loc *Location, err Error) {
 	})
 }
 
+// MakeError returns the ValidationError including the warnings, when there are errors.
 func (c *standardErrorCollector) MakeError() error {
 	if c.HasError() {
 		return NewValidationError(c.nameResolver, c.errors)
@@ -117,6 +294,80 @@ func (c *standardErrorCollector) MakeError() error {
 	return nil
 }
 
+// MakeWarning returns the ValidationError including only the warnings, when there are warnings.
+func (c *standardErrorCollector) MakeWarning() error {
+	if c.HasWarning() {
+		return NewValidationError(c.nameResolver, NewValidationError(c.nameResolver, c.errors).filter(SeverityWarning))
+	}
+	return nil
+}
+
+func (c *recordingErrorCollector) Add(loc *Location, err Error) {
+	c.errors = append(c.errors, &LocationError{
+		Location: loc,
+		Error:    err,
+	})
+	c.ErrorCollector.Add(loc, err)
+}
+
+func (c *recordingErrorCollector) HasWarning() bool {
+	if collector, ok := c.ErrorCollector.(WarningCollector); ok {
+		return collector.HasWarning()
+	}
+	return false
+}
+
+func (c *recordingErrorCollector) MakeWarning() error {
+	if collector, ok := c.ErrorCollector.(WarningCollector); ok {
+		return collector.MakeWarning()
+	}
+	return nil
+}
+
+// count returns the number of recorded errors of the severity.
+func (c *recordingErrorCollector) count(severity Severity) int {
+	n := 0
+	for _, locErr := range c.errors {
+		if locErr.Severity() == severity {
+			n++
+		}
+	}
+	return n
+}
+
+func newLimitedErrorCollector(errorCollector ErrorCollector, max int) ErrorCollector {
+	return &limitedErrorCollector{ErrorCollector: errorCollector, max: max}
+}
+
+func (c *limitedErrorCollector) Add(loc *Location, err Error) {
+	if c.IsFull() {
+		return
+	}
+	// NOTE: the warnings are not counted, because they do not fail the validation.
+	if err.Severity() == SeverityError {
+		c.count++
+	}
+	c.ErrorCollector.Add(loc, err)
+}
+
+func (c *limitedErrorCollector) HasWarning() bool {
+	if collector, ok := c.ErrorCollector.(WarningCollector); ok {
+		return collector.HasWarning()
+	}
+	return false
+}
+
+func (c *limitedErrorCollector) MakeWarning() error {
+	if collector, ok := c.ErrorCollector.(WarningCollector); ok {
+		return collector.MakeWarning()
+	}
+	return nil
+}
+
+func (c *limitedErrorCollector) IsFull() bool {
+	return c.count >= c.max
+}
+
 func NewError(code string, value interface{}, params ...interface{}) Error {
 	return &errorDetail{
 		code:   code,
@@ -125,6 +376,17 @@ func NewError(code string, value interface{}, params ...interface{}) Error {
 	}
 }
 
+// NewWarning returns a new Error of SeverityWarning.
+// It is reported, but the validation does not fail only with warnings.
+func NewWarning(code string, value interface{}, params ...interface{}) Error {
+	return &errorDetail{
+		code:     code,
+		params:   params,
+		value:    value,
+		severity: SeverityWarning,
+	}
+}
+
 // Code returns an error code.
 // See also code sub-package.
 func (e *errorDetail) Code() string {
@@ -151,6 +413,47 @@ func (e *errorDetail) ValueBeforeConversion() interface{} {
 	return e.valueBeforeConversion
 }
 
+// MessageKey returns the key of the message in the catalog.
+// It is the Code, unless the message is replaced by WithMessage.
+func (e *errorDetail) MessageKey() string {
+	if e.message == "" {
+		return e.code
+	}
+	return e.message
+}
+
+// sprint returns the message translated by the printer.
+func (e *errorDetail) sprint(p *message.Printer) string {
+	if e.literal {
+		// NOTE: the message is not a format, so it is used as is when the translation does not exist.
+		if translated := p.Sprintf(message.Key(e.message, translationNotFound)); translated != translationNotFound {
+			return translated
+		}
+		return e.message
+	}
+	return p.Sprintf(e.MessageKey(), e.params...)
+}
+
+// Severity returns the severity of the error.
+func (e *errorDetail) Severity() Severity {
+	return e.severity
+}
+
 func (e *errorDetail) Error() string {
 	return e.code
 }
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// MarshalText encodes the Severity to the name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -35,14 +35,23 @@ func (r *fieldRule) Validate(validator *Validator, value interface{}) {
 	}
 
 	field := valishelpers.GetField(value, r.fieldPtr)
-	validator.DiveField(field, func(v *Validator) {
-		And(r.rules...).Validate(v, reflect.ValueOf(r.fieldPtr).Elem().Interface())
+	fieldVal := reflect.ValueOf(r.fieldPtr).Elem()
+	validator.dive(validator.loc.FieldLocation(field), &valueNode{ref: fieldVal}, func(v *Validator) {
+		And(r.rules...).Validate(v, fieldVal.Interface())
 	})
 }
 
 // EachFields returns a new rule that verifies all field values of the struct meet the rules and all common rules.
+//
+// The embedded fields and the fields of the embedded structs are verified as the fields of the struct.
+// The fields promoted in the same way as encoding/json are located without the embedded struct,
+// and the others (e.g. the shadowed fields) are located through it. See also valishelpers.StructFields.
+// EachFields does not verify the fields of the embedded struct again, when it is given the value of the embedded field.
+//
+// The fields and the rules created from the field tags are compiled once per struct type and cached,
+// so it is not necessary to reflect the struct every time.
 func EachFields(rules ...Rule) Rule {
-	return &eachFieldsRule{rules: rules}
+	return &eachFieldsRule{rules: newFieldRules(rules)}
 }
 
 func (rule *eachFieldsRule) Validate(validator *Validator, value interface{}) {
@@ -53,14 +62,26 @@ func (rule *eachFieldsRule) Validate(validator *Validator, value interface{}) {
 
 	switch val.Kind() {
 	case reflect.Struct:
-		for i := 0; i < val.NumField(); i++ {
-			fieldVal := val.Field(i)
-			if !(fieldVal.IsValid() && fieldVal.CanInterface()) {
+		// NOTE: the fields have been verified as the fields of the parent struct.
+		if validator.node != nil && validator.node.embedded {
+			return
+		}
+		plan := loadStructPlan(val.Type())
+		for _, fieldPlan := range plan.fields {
+			if validator.isStopped() {
 				return
 			}
-			field := val.Type().Field(i)
-			validator.DiveField(&field, func(v *Validator) {
-				And(rule.rules...).Validate(v, fieldVal.Interface())
+			// NOTE: it does not dive into the field when no rules are applied.
+			if len(validator.commonRules) == 0 && !rule.rules.hasRules(fieldPlan) {
+				continue
+			}
+			fieldPlan := fieldPlan
+			fieldVal, ok := fieldPlan.value(val)
+			if !ok {
+				continue
+			}
+			validator.dive(fieldPlan.location(validator.loc), &valueNode{ref: fieldVal, embedded: fieldPlan.embedded}, func(v *Validator) {
+				rule.rules.validate(v, fieldPlan, fieldVal.Interface())
 			})
 		}
 	default:
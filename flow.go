@@ -14,6 +14,7 @@ type (
 	WhenContext struct {
 		value interface{}
 		loc   *Location
+		node  *valueNode
 	}
 )
 
@@ -32,7 +33,7 @@ type (
 		rules []Rule
 	}
 	eachFieldsRule struct {
-		rules []Rule
+		rules *fieldRules
 	}
 )
 
@@ -43,14 +44,15 @@ func And(rules ...Rule) Rule {
 }
 
 func (r *andRule) Validate(validator *Validator, value interface{}) {
-	if len(validator.commonRules) > 0 {
-		rules := append(validator.commonRules, r.rules...)
+	validator.setValue(value)
+	for _, rules := range [...][]Rule{validator.commonRules, r.rules} {
 		for _, rule := range rules {
+			if validator.isStopped() {
+				return
+			}
 			rule.Validate(validator, value)
-		}
-	} else {
-		for _, rule := range r.rules {
-			rule.Validate(validator, value)
+			// NOTE: the value may be transformed by the rule.
+			value = validator.node.value
 		}
 	}
 }
@@ -61,10 +63,22 @@ func Or(rules ...Rule) Rule {
 }
 
 func (r *orRule) Validate(validator *Validator, value interface{}) {
+	if validator.isStopped() {
+		return
+	}
 	for _, rule := range r.rules {
-		newValidator := validator.Clone(&CloneOpts{InheritLocation: true})
+		errorCollector, recorder := validator.newRecordingErrorCollector()
+		newValidator := validator.Clone(&CloneOpts{InheritLocation: true, ErrorCollector: errorCollector})
 		rule.Validate(newValidator, value)
-		if !newValidator.ErrorCollector().HasError() {
+		if !errorCollector.HasError() {
+			// NOTE: the warnings of the rule that is met are kept.
+			if c, ok := errorCollector.(WarningCollector); ok && c.HasWarning() {
+				for _, locErr := range recorder.errors {
+					if locErr.Severity() == SeverityWarning {
+						validator.ErrorCollector().Add(locErr.Location, locErr.Error)
+					}
+				}
+			}
 			return
 		}
 	}
@@ -100,7 +114,7 @@ func (r WhenRule) Else(rules ...Rule) Rule {
 
 // See Rule.Validate
 func (r WhenRule) Validate(validator *Validator, value interface{}) {
-	ctx := &WhenContext{loc: validator.loc, value: value}
+	ctx := &WhenContext{loc: validator.loc, value: value, node: validator.node}
 	for _, condAndRule := range r.condAndRules {
 		if condAndRule.cond(ctx) {
 			for _, rule := range condAndRule.rules {
@@ -121,6 +135,16 @@ func (ctx *WhenContext) Value() interface{} {
 	return ctx.value
 }
 
+// Parent returns the value of the parent location. See also Validator.Parent.
+func (ctx *WhenContext) Parent() interface{} {
+	return ctx.node.parentValue()
+}
+
+// Root returns the value given to Validate. See also Validator.Root.
+func (ctx *WhenContext) Root() interface{} {
+	return ctx.node.rootValue()
+}
+
 // Each returns a new rule that verifies all elements of the array or slice meet the rules and all common rules.
 func Each(rules ...Rule) Rule {
 	return &eachRule{rules: rules}
@@ -135,9 +159,12 @@ func (rule *eachRule) Validate(validator *Validator, value interface{}) {
 	switch val.Kind() {
 	case reflect.Slice, reflect.Array:
 		for i := 0; i < val.Len(); i++ {
-			indexValue := val.Index(i).Interface()
-			validator.DiveIndex(i, func(v *Validator) {
-				And(rule.rules...).Validate(v, indexValue)
+			if validator.isStopped() {
+				return
+			}
+			indexVal := val.Index(i)
+			validator.dive(validator.loc.IndexLocation(i), &valueNode{ref: indexVal}, func(v *Validator) {
+				And(rule.rules...).Validate(v, indexVal.Interface())
 			})
 		}
 	default:
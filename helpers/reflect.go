@@ -3,6 +3,21 @@ package valishelpers
 
 import (
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// embeddedStruct is the struct type to flatten, and the index sequence to reach it.
+	embeddedStruct struct {
+		ty    reflect.Type
+		index []int
+	}
+)
+
+var (
+	structFieldsCache sync.Map // map[reflect.Type][]reflect.StructField
 )
 
 // GetField returns the *reflect.StructField of the fieldPointer. When it is not found, it panics.
@@ -31,9 +46,166 @@ func GetField(structPointer interface{}, fieldPointer interface{}) *reflect.Stru
 			return &strField
 		}
 	}
+	// NOTE: the field may be promoted from the embedded struct.
+	for _, strField := range StructFields(structVal.Type()) {
+		if len(strField.Index) == 1 {
+			continue
+		}
+		if field, ok := FieldByIndex(structVal, strField.Index); ok && field.Addr() == fieldVal {
+			strField := strField
+			return &strField
+		}
+	}
 	panic("invalid fieldPointer")
 }
 
+// StructFields returns the exported fields of the struct type including the fields promoted from the embedded structs.
+// The Index of each field is the index sequence for FieldByIndex, and the fields are sorted in order of it.
+//
+// The embedded structs are flattened in the same way as encoding/json.
+// An embedded struct (or a pointer to struct) is not returned and its fields are promoted, unless it has the name in the json tag.
+// A struct field that has the inline option in the json tag (e.g. `json:",inline"`) is flattened in the same way.
+// When some fields have the same name in JSON, the shallowest field is returned. If there are multiple shallowest fields,
+// the field that has the name in the json tag is returned, or none of them are returned.
+// The fields ignored in JSON (e.g. `json:"-"`) are dropped before it, so they never hide the other fields,
+// but they are still returned because they may be validated.
+func StructFields(ty reflect.Type) []reflect.StructField {
+	if fields, ok := structFieldsCache.Load(ty); ok {
+		return fields.([]reflect.StructField)
+	}
+
+	type candidate struct {
+		field  reflect.StructField
+		tagged bool
+	}
+	candidates := make(map[string][]*candidate)
+	ignored := make([]reflect.StructField, 0)
+
+	visited := make(map[reflect.Type]bool)
+	current := []*embeddedStruct{{ty: ty}}
+	for len(current) > 0 {
+		next := make([]*embeddedStruct, 0)
+		for _, embedded := range current {
+			if visited[embedded.ty] {
+				continue
+			}
+			for i := 0; i < embedded.ty.NumField(); i++ {
+				field := embedded.ty.Field(i)
+				field.Index = append(append(make([]int, 0, len(embedded.index)+1), embedded.index...), i)
+
+				fieldTy, flattened := FlattenedStructType(&field)
+				// NOTE: the exported fields of unexported embedded structs are promoted.
+				if field.PkgPath != "" && !(field.Anonymous && flattened) {
+					continue
+				}
+				if flattened {
+					next = append(next, &embeddedStruct{ty: fieldTy, index: field.Index})
+					continue
+				}
+				if field.PkgPath != "" {
+					continue
+				}
+
+				tag := field.Tag.Get("json")
+				if tag == "-" {
+					ignored = append(ignored, field)
+					continue
+				}
+
+				name := strings.Split(tag, ",")[0]
+				tagged := name != ""
+				if !tagged {
+					name = field.Name
+				}
+				candidates[name] = append(candidates[name], &candidate{field: field, tagged: tagged})
+			}
+		}
+		for _, embedded := range current {
+			visited[embedded.ty] = true
+		}
+		current = next
+	}
+
+	fields := make([]reflect.StructField, 0, len(candidates)+len(ignored))
+	fields = append(fields, ignored...)
+	for _, cs := range candidates {
+		// NOTE: the candidates are appended in order of the depth.
+		dominants := make([]*candidate, 0, len(cs))
+		for _, c := range cs {
+			if len(c.field.Index) == len(cs[0].field.Index) {
+				dominants = append(dominants, c)
+			}
+		}
+		if len(dominants) > 1 {
+			tagged := dominants[:0]
+			for _, c := range dominants {
+				if c.tagged {
+					tagged = append(tagged, c)
+				}
+			}
+			dominants = tagged
+		}
+		if len(dominants) == 1 {
+			fields = append(fields, dominants[0].field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		x, y := fields[i].Index, fields[j].Index
+		for k := 0; k < len(x) && k < len(y); k++ {
+			if x[k] != y[k] {
+				return x[k] < y[k]
+			}
+		}
+		return len(x) < len(y)
+	})
+
+	actual, _ := structFieldsCache.LoadOrStore(ty, fields)
+	return actual.([]reflect.StructField)
+}
+
+// FlattenedStructType returns the struct type of the field, when the fields of it are promoted in the same way as encoding/json.
+// It is the embedded struct (or a pointer to struct) that does not have the name in the json tag,
+// or the struct field that has the inline option in the json tag. See also StructFields.
+func FlattenedStructType(field *reflect.StructField) (reflect.Type, bool) {
+	ty := field.Type
+	if ty.Name() == "" && ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	tag := field.Tag.Get("json")
+	attrs := strings.Split(tag, ",")
+	if tag == "-" || attrs[0] != "" || ty.Kind() != reflect.Struct {
+		return nil, false
+	}
+	if field.Anonymous || hasJSONOption(attrs[1:], "inline") {
+		return ty, true
+	}
+	return nil, false
+}
+
+// FieldByIndex returns the nested field of the struct value corresponding to the index sequence.
+// Unlike reflect.Value.FieldByIndex, it returns false instead of panicking when it steps through a nil pointer.
+func FieldByIndex(structVal reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && structVal.Kind() == reflect.Ptr {
+			if structVal.IsNil() {
+				return reflect.Value{}, false
+			}
+			structVal = structVal.Elem()
+		}
+		structVal = structVal.Field(x)
+	}
+	return structVal, true
+}
+
+func hasJSONOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
 // IsNumeric returns true if v is numeric type. Otherwise, it returns false.
 func IsNumeric(v interface{}) bool {
 	val := reflect.ValueOf(v)
@@ -64,3 +236,14 @@ func IsNil(v interface{}) bool {
 		return false
 	}
 }
+
+// JSONFieldName returns the name of the field in JSON.
+// It is the name specified in the json tag, or the name of the field when it is not specified.
+func JSONFieldName(field *reflect.StructField) string {
+	if val := field.Tag.Get("json"); val != "" && val != "-" {
+		if name := strings.Split(val, ",")[0]; name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
@@ -1,6 +1,9 @@
 package valishelpers
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 func ExampleGetField() {
 	type User struct {
@@ -47,3 +50,18 @@ func ExampleIsNil() {
 	// (*string)(nil) is nil
 	// "" is not nil
 }
+
+func ExampleJSONFieldName() {
+	type User struct {
+		Name string `json:"name,omitempty"`
+		Age  int    `json:",omitempty"`
+	}
+	ty := reflect.TypeOf(User{})
+	for i := 0; i < ty.NumField(); i++ {
+		field := ty.Field(i)
+		fmt.Println(JSONFieldName(&field))
+	}
+	// Output:
+	// name
+	// Age
+}
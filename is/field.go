@@ -0,0 +1,257 @@
+package is
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	valishelpers "github.com/soranoba/valis/helpers"
+)
+
+type (
+	fieldComparisonRule struct {
+		name string
+		code string
+		ok   func(cmp int) bool
+	}
+	requiredIfRule struct {
+		name     string
+		values   []interface{}
+		excluded bool
+	}
+	requiredWithRule struct {
+		names   []string
+		without bool
+	}
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// EqualToField returns a rule to verify that the value is equal to the sibling field specified by the name.
+// See also GreaterThanField.
+func EqualToField(name string) valis.Rule {
+	return &fieldComparisonRule{name: name, code: code.EqualField, ok: func(cmp int) bool { return cmp == 0 }}
+}
+
+// NotEqualToField returns a rule to verify that the value is not equal to the sibling field specified by the name.
+// See also GreaterThanField.
+func NotEqualToField(name string) valis.Rule {
+	return &fieldComparisonRule{name: name, code: code.NotEqualField, ok: func(cmp int) bool { return cmp != 0 }}
+}
+
+// GreaterThanField returns a rule to verify that the value > the sibling field specified by the name.
+//
+// The sibling field is found by the Go field name from the parent struct (See also valis.Validator.Parent).
+// It supports numerics, strings and time.Time. When the value or the sibling field is nil, it is not verified.
+func GreaterThanField(name string) valis.Rule {
+	return &fieldComparisonRule{name: name, code: code.GreaterThanField, ok: func(cmp int) bool { return cmp > 0 }}
+}
+
+// LessThanField returns a rule to verify that the value < the sibling field specified by the name.
+// See also GreaterThanField.
+func LessThanField(name string) valis.Rule {
+	return &fieldComparisonRule{name: name, code: code.LessThanField, ok: func(cmp int) bool { return cmp < 0 }}
+}
+
+// RequiredIf returns a rule to verify that the value is present, when the sibling field specified by the name is one of the values.
+//
+// The present value means non-zero value in the same way as NonZero.
+// The sibling field is found by the Go field name from the parent struct (See also valis.Validator.Parent),
+// and it is compared with the values after converting to strings.
+func RequiredIf(name string, values ...interface{}) valis.Rule {
+	return &requiredIfRule{name: name, values: values}
+}
+
+// ExcludedIf returns a rule to verify that the value is not present, when the sibling field specified by the name is one of the values.
+// See also RequiredIf.
+func ExcludedIf(name string, values ...interface{}) valis.Rule {
+	return &requiredIfRule{name: name, values: values, excluded: true}
+}
+
+// RequiredWith returns a rule to verify that the value is present, when any of the sibling fields specified by the names are present.
+// See also RequiredIf.
+func RequiredWith(names ...string) valis.Rule {
+	return &requiredWithRule{names: names}
+}
+
+// RequiredWithout returns a rule to verify that the value is present, when any of the sibling fields specified by the names are not present.
+// See also RequiredIf.
+func RequiredWithout(names ...string) valis.Rule {
+	return &requiredWithRule{names: names, without: true}
+}
+
+func (rule *fieldComparisonRule) Validate(validator *valis.Validator, value interface{}) {
+	fieldVal, ok := siblingField(validator, value, rule.name)
+	if !ok {
+		return
+	}
+
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	for fieldVal.Kind() == reflect.Ptr || fieldVal.Kind() == reflect.Interface {
+		fieldVal = fieldVal.Elem()
+	}
+	if !val.IsValid() || !fieldVal.IsValid() {
+		return
+	}
+
+	cmp, ok := compare(val, fieldVal)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Invalid, value))
+		return
+	}
+	if !rule.ok(cmp) {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(rule.code, value, rule.name))
+	}
+}
+
+func (rule *requiredIfRule) Validate(validator *valis.Validator, value interface{}) {
+	fieldVal, ok := siblingField(validator, value, rule.name)
+	if !ok {
+		return
+	}
+	for fieldVal.Kind() == reflect.Ptr || fieldVal.Kind() == reflect.Interface {
+		fieldVal = fieldVal.Elem()
+	}
+	if !fieldVal.IsValid() || !fieldVal.CanInterface() {
+		return
+	}
+
+	fieldStr, err := henge.New(fieldVal.Interface()).String().Result()
+	if err != nil {
+		return
+	}
+	for _, v := range rule.values {
+		if s, err := henge.New(v).String().Result(); err != nil || s != fieldStr {
+			continue
+		}
+
+		switch {
+		case rule.excluded && isPresent(reflect.ValueOf(value)):
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.ExcludedIf, value, rule.name, fieldStr))
+		case !rule.excluded && !isPresent(reflect.ValueOf(value)):
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.RequiredIf, value, rule.name, fieldStr))
+		}
+		return
+	}
+}
+
+func (rule *requiredWithRule) Validate(validator *valis.Validator, value interface{}) {
+	if isPresent(reflect.ValueOf(value)) {
+		return
+	}
+	for _, name := range rule.names {
+		fieldVal, ok := siblingField(validator, value, name)
+		if !ok {
+			return
+		}
+
+		switch {
+		case rule.without && !isPresent(fieldVal):
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.RequiredWithout, value, name))
+			return
+		case !rule.without && isPresent(fieldVal):
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.RequiredWith, value, name))
+			return
+		}
+	}
+}
+
+// siblingField returns the field specified by the Go field name from the parent struct.
+// The fields of the embedded structs are promoted, and it returns an invalid value when the embedded struct is a nil pointer.
+// When the parent is not a struct or it does not have the field, it adds an error and returns false.
+func siblingField(validator *valis.Validator, value interface{}, name string) (reflect.Value, bool) {
+	parentVal := reflect.ValueOf(validator.Parent())
+	for parentVal.Kind() == reflect.Ptr {
+		parentVal = parentVal.Elem()
+	}
+	if parentVal.Kind() != reflect.Struct {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotStructField, value))
+		return reflect.Value{}, false
+	}
+
+	field, ok := parentVal.Type().FieldByName(name)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NoField, value, name))
+		return reflect.Value{}, false
+	}
+	fieldVal, _ := valishelpers.FieldByIndex(parentVal, field.Index)
+	return fieldVal, true
+}
+
+// isPresent returns true, when the value is non-zero value.
+func isPresent(val reflect.Value) bool {
+	return val.IsValid() && !val.IsZero()
+}
+
+// compare returns -1, 0 or +1 depending on whether x < y, x == y or x > y.
+// When x and y can not be compared, it returns false.
+func compare(x reflect.Value, y reflect.Value) (int, bool) {
+	switch {
+	case x.Type() == timeType && y.Type() == timeType && x.CanInterface() && y.CanInterface():
+		tx, ty := x.Interface().(time.Time), y.Interface().(time.Time)
+		switch {
+		case tx.Before(ty):
+			return -1, true
+		case tx.After(ty):
+			return 1, true
+		}
+		return 0, true
+	case x.Kind() == reflect.String && y.Kind() == reflect.String:
+		return compareOrdered(x.String() < y.String(), x.String() > y.String()), true
+	case isInt(x) && isInt(y):
+		return compareOrdered(x.Int() < y.Int(), x.Int() > y.Int()), true
+	case isUint(x) && isUint(y):
+		return compareOrdered(x.Uint() < y.Uint(), x.Uint() > y.Uint()), true
+	case isNumber(x) && isNumber(y):
+		fx, fy := toFloat(x), toFloat(y)
+		return compareOrdered(fx < fy, fx > fy), true
+	}
+	return 0, false
+}
+
+func compareOrdered(less bool, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func isInt(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUint(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isNumber(val reflect.Value) bool {
+	return isInt(val) || isUint(val) || val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64
+}
+
+func toFloat(val reflect.Value) float64 {
+	switch {
+	case isInt(val):
+		return float64(val.Int())
+	case isUint(val):
+		return float64(val.Uint())
+	}
+	return val.Float()
+}
@@ -0,0 +1,143 @@
+package is
+
+import (
+	"encoding/base64"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+type (
+	formatRule struct {
+		code string
+		ok   func(s string) bool
+	}
+)
+
+var (
+	hostnameLabelRegexp = regexp.MustCompile("^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$")
+	upperAlpha2Regexp   = regexp.MustCompile("^[A-Z]{2}$")
+	upperAlpha3Regexp   = regexp.MustCompile("^[A-Z]{3}$")
+)
+
+var (
+	// UUID is a rule to verify UUID (e.g. 123e4567-e89b-12d3-a456-426614174000).
+	// It does not verify the version and the variant.
+	UUID valis.Rule = &matchRule{
+		re:   regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"),
+		code: code.InvalidUUIDFormat,
+	}
+	// IP is a rule to verify IPv4 or IPv6 address.
+	IP valis.Rule = &formatRule{code: code.InvalidIPFormat, ok: func(s string) bool {
+		return net.ParseIP(s) != nil
+	}}
+	// IPv4 is a rule to verify IPv4 address (e.g. 192.0.2.1).
+	IPv4 valis.Rule = &formatRule{code: code.InvalidIPv4Format, ok: func(s string) bool {
+		return net.ParseIP(s) != nil && !strings.Contains(s, ":")
+	}}
+	// IPv6 is a rule to verify IPv6 address (e.g. 2001:db8::1).
+	IPv6 valis.Rule = &formatRule{code: code.InvalidIPv6Format, ok: func(s string) bool {
+		return net.ParseIP(s) != nil && strings.Contains(s, ":")
+	}}
+	// CIDR is a rule to verify CIDR notation (e.g. 192.0.2.0/24, 2001:db8::/32).
+	CIDR valis.Rule = &formatRule{code: code.InvalidCIDRFormat, ok: func(s string) bool {
+		_, _, err := net.ParseCIDR(s)
+		return err == nil
+	}}
+	// MAC is a rule to verify MAC address (e.g. 00:00:5e:00:53:01).
+	// See also net.ParseMAC.
+	MAC valis.Rule = &formatRule{code: code.InvalidMACFormat, ok: func(s string) bool {
+		_, err := net.ParseMAC(s)
+		return err == nil
+	}}
+	// Hostname is a rule to verify hostname. ref: RFC 1123
+	Hostname valis.Rule = &formatRule{code: code.InvalidHostnameFormat, ok: isHostname}
+	// FQDN is a rule to verify fully qualified domain name (e.g. example.com).
+	// It accepts the trailing dot, and does not accept the top-level domain consisting only of numbers.
+	FQDN valis.Rule = &formatRule{code: code.InvalidFQDNFormat, ok: func(s string) bool {
+		s = strings.TrimSuffix(s, ".")
+		i := strings.LastIndex(s, ".")
+		return i > 0 && isHostname(s) && strings.Trim(s[i+1:], "0123456789") != ""
+	}}
+	// Semver is a rule to verify semantic version (e.g. 1.0.0-alpha+001). ref: https://semver.org
+	Semver valis.Rule = &matchRule{
+		re:   regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`),
+		code: code.InvalidSemverFormat,
+	}
+	// Base64 is a rule to verify the string encoded by the standard base64 encoding with padding.
+	Base64 valis.Rule = &formatRule{code: code.InvalidBase64Format, ok: func(s string) bool {
+		_, err := base64.StdEncoding.DecodeString(s)
+		return err == nil
+	}}
+	// HexColor is a rule to verify hex color code (e.g. #fff, #ffffff, #ffffff80).
+	HexColor valis.Rule = &matchRule{
+		re:   regexp.MustCompile("^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"),
+		code: code.InvalidHexColorFormat,
+	}
+	// CountryCode is a rule to verify ISO 3166-1 alpha-2 country code (e.g. JP).
+	CountryCode valis.Rule = &formatRule{code: code.InvalidCountryCode, ok: func(s string) bool {
+		return upperAlpha2Regexp.MatchString(s) && isCountry(s)
+	}}
+	// CountryCodeAlpha3 is a rule to verify ISO 3166-1 alpha-3 country code (e.g. JPN).
+	CountryCodeAlpha3 valis.Rule = &formatRule{code: code.InvalidCountryCode, ok: func(s string) bool {
+		return upperAlpha3Regexp.MatchString(s) && isCountry(s)
+	}}
+	// CurrencyCode is a rule to verify ISO 4217 currency code (e.g. JPY).
+	CurrencyCode valis.Rule = &formatRule{code: code.InvalidCurrencyCode, ok: func(s string) bool {
+		if !upperAlpha3Regexp.MatchString(s) {
+			return false
+		}
+		_, err := currency.ParseISO(s)
+		return err == nil
+	}}
+	// LanguageTag is a rule to verify BCP 47 language tag (e.g. en, ja-JP, zh-Hans).
+	LanguageTag valis.Rule = &formatRule{code: code.InvalidLanguageTag, ok: func(s string) bool {
+		// NOTE: language.Parse accepts "_" as the separator, but BCP 47 does not.
+		if strings.Contains(s, "_") {
+			return false
+		}
+		_, err := language.Parse(s)
+		return err == nil
+	}}
+)
+
+func (rule *formatRule) Validate(validator *valis.Validator, value interface{}) {
+	s, ok := toString(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotString, value))
+		return
+	}
+	if !rule.ok(s) {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(rule.code, value))
+	}
+}
+
+func isHostname(s string) bool {
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(s, "."), ".") {
+		if !hostnameLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCountry returns true, when s is the ISO 3166-1 alpha-2 or alpha-3 code of the country.
+// NOTE: language.ParseRegion accepts the deprecated and reserved codes (e.g. UK), so it compares with the canonical code.
+func isCountry(s string) bool {
+	region, err := language.ParseRegion(s)
+	if err != nil || !region.IsCountry() || region.ISO3() == "ZZZ" {
+		return false
+	}
+	if len(s) == 2 {
+		return region.String() == s
+	}
+	return region.ISO3() == s
+}
@@ -0,0 +1,283 @@
+package is
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeBreak is the Grapheme_Cluster_Break property of UAX #29.
+type graphemeBreak int
+
+const (
+	graphemeBreakOther graphemeBreak = iota
+	graphemeBreakCR
+	graphemeBreakLF
+	graphemeBreakControl
+	graphemeBreakPrepend
+	graphemeBreakExtend
+	graphemeBreakZWJ
+	graphemeBreakRegionalIndicator
+	graphemeBreakSpacingMark
+	graphemeBreakL
+	graphemeBreakV
+	graphemeBreakT
+	graphemeBreakLV
+	graphemeBreakLVT
+	graphemeBreakExtendedPictographic
+)
+
+// indicConjunctBreak is the Indic_Conjunct_Break property of UAX #44.
+type indicConjunctBreak int
+
+const (
+	indicConjunctBreakNone indicConjunctBreak = iota
+	indicConjunctBreakConsonant
+	indicConjunctBreakLinker
+	indicConjunctBreakExtend
+)
+
+var (
+	// prepend is the characters of the Prepend property of UAX #29.
+	prepend = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+			{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+			{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+			{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+			{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+			{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+			{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+			{Lo: 0x111c2, Hi: 0x111c3, Stride: 1},
+			{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+			{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+			{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+			{Lo: 0x11a84, Hi: 0x11a89, Stride: 1},
+			{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+			{Lo: 0x11f02, Hi: 0x11f02, Stride: 1},
+		},
+	}
+	// indicConjunctLinker is the characters of InCB=Linker of UAX #44.
+	indicConjunctLinker = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x094d, Hi: 0x094d, Stride: 1},
+			{Lo: 0x09cd, Hi: 0x09cd, Stride: 1},
+			{Lo: 0x0acd, Hi: 0x0acd, Stride: 1},
+			{Lo: 0x0b4d, Hi: 0x0b4d, Stride: 1},
+			{Lo: 0x0c4d, Hi: 0x0c4d, Stride: 1},
+			{Lo: 0x0d4d, Hi: 0x0d4d, Stride: 1},
+		},
+	}
+	// indicConjunctConsonant is the characters of InCB=Consonant of UAX #44.
+	indicConjunctConsonant = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0915, Hi: 0x0939, Stride: 1},
+			{Lo: 0x0958, Hi: 0x095f, Stride: 1},
+			{Lo: 0x0978, Hi: 0x097f, Stride: 1},
+			{Lo: 0x0995, Hi: 0x09a8, Stride: 1},
+			{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+			{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+			{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+			{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+			{Lo: 0x09df, Hi: 0x09df, Stride: 1},
+			{Lo: 0x09f0, Hi: 0x09f1, Stride: 1},
+			{Lo: 0x0a95, Hi: 0x0aa8, Stride: 1},
+			{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+			{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+			{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+			{Lo: 0x0af9, Hi: 0x0af9, Stride: 1},
+			{Lo: 0x0b15, Hi: 0x0b28, Stride: 1},
+			{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+			{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+			{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+			{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+			{Lo: 0x0b5f, Hi: 0x0b5f, Stride: 1},
+			{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+			{Lo: 0x0c15, Hi: 0x0c28, Stride: 1},
+			{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+			{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+			{Lo: 0x0d15, Hi: 0x0d3a, Stride: 1},
+		},
+	}
+	// extendedPictographic is an approximation of the Extended_Pictographic property of UTS #51.
+	extendedPictographic = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+			{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+			{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+			{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+			{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+			{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+			{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+			{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+			{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+			{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+			{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+			{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+			{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+			{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+			{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+			{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+			{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+			{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+			{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+			{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+			{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+			{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+			{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+			{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+			{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+			{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+			{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+			{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f000, Hi: 0x1f1e5, Stride: 1},
+			{Lo: 0x1f200, Hi: 0x1f3fa, Stride: 1},
+			{Lo: 0x1f400, Hi: 0x1faff, Stride: 1},
+			{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+		},
+	}
+)
+
+// graphemeLength returns the number of extended grapheme clusters in s. ref: UAX #29
+//
+// The properties are approximated by the tables of the unicode package and the ranges of Unicode 15.1,
+// so it may count differently from the latest Unicode in some scripts.
+func graphemeLength(s string) int {
+	count := 0
+	prev := graphemeBreakOther
+	// inPictographic is true, when the previous characters are ExtendedPictographic Extend*.
+	inPictographic := false
+	// afterPictographicZWJ is true, when the previous characters are ExtendedPictographic Extend* ZWJ.
+	afterPictographicZWJ := false
+	// numRegionalIndicators is the number of the consecutive RegionalIndicator before the current character.
+	numRegionalIndicators := 0
+	// inConjunct is true, when the previous characters are InCB=Consonant [InCB=Extend InCB=Linker]*.
+	inConjunct := false
+	// afterConjunctLinker is true, when the previous characters of the conjunct include InCB=Linker.
+	afterConjunctLinker := false
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		cur := graphemeBreakOf(r)
+		incb := indicConjunctBreakOf(r, cur)
+		linkedConsonant := afterConjunctLinker && incb == indicConjunctBreakConsonant
+
+		if i == 0 || isGraphemeBoundary(prev, cur, afterPictographicZWJ, numRegionalIndicators, linkedConsonant) {
+			count++
+		}
+
+		afterPictographicZWJ = cur == graphemeBreakZWJ && inPictographic
+		switch {
+		case cur == graphemeBreakExtendedPictographic:
+			inPictographic = true
+		case cur == graphemeBreakExtend && inPictographic:
+		default:
+			inPictographic = false
+		}
+		if cur == graphemeBreakRegionalIndicator {
+			numRegionalIndicators++
+		} else {
+			numRegionalIndicators = 0
+		}
+		switch {
+		case incb == indicConjunctBreakConsonant:
+			inConjunct, afterConjunctLinker = true, false
+		case incb == indicConjunctBreakLinker && inConjunct:
+			afterConjunctLinker = true
+		case incb == indicConjunctBreakExtend && inConjunct:
+		default:
+			inConjunct, afterConjunctLinker = false, false
+		}
+
+		prev = cur
+		i += size
+	}
+	return count
+}
+
+// isGraphemeBoundary returns true, when there is a grapheme cluster boundary between prev and cur.
+// linkedConsonant is true, when cur is InCB=Consonant following the conjunct that includes InCB=Linker.
+func isGraphemeBoundary(prev graphemeBreak, cur graphemeBreak, afterPictographicZWJ bool, numRegionalIndicators int, linkedConsonant bool) bool {
+	switch {
+	case prev == graphemeBreakCR && cur == graphemeBreakLF: // GB3
+		return false
+	case prev == graphemeBreakCR || prev == graphemeBreakLF || prev == graphemeBreakControl: // GB4
+		return true
+	case cur == graphemeBreakCR || cur == graphemeBreakLF || cur == graphemeBreakControl: // GB5
+		return true
+	case prev == graphemeBreakL && (cur == graphemeBreakL || cur == graphemeBreakV || cur == graphemeBreakLV || cur == graphemeBreakLVT): // GB6
+		return false
+	case (prev == graphemeBreakLV || prev == graphemeBreakV) && (cur == graphemeBreakV || cur == graphemeBreakT): // GB7
+		return false
+	case (prev == graphemeBreakLVT || prev == graphemeBreakT) && cur == graphemeBreakT: // GB8
+		return false
+	case cur == graphemeBreakExtend || cur == graphemeBreakZWJ || cur == graphemeBreakSpacingMark: // GB9, GB9a
+		return false
+	case prev == graphemeBreakPrepend: // GB9b
+		return false
+	case linkedConsonant: // GB9c
+		return false
+	case afterPictographicZWJ && cur == graphemeBreakExtendedPictographic: // GB11
+		return false
+	case prev == graphemeBreakRegionalIndicator && cur == graphemeBreakRegionalIndicator: // GB12, GB13
+		return numRegionalIndicators%2 == 0
+	}
+	return true // GB999
+}
+
+func graphemeBreakOf(r rune) graphemeBreak {
+	switch {
+	case r == '\r':
+		return graphemeBreakCR
+	case r == '\n':
+		return graphemeBreakLF
+	case r == 0x200d:
+		return graphemeBreakZWJ
+	case r == 0x200c, 0x1f3fb <= r && r <= 0x1f3ff, 0xe0020 <= r && r <= 0xe007f:
+		// NOTE: ZWNJ, emoji modifiers and tags are Extend.
+		return graphemeBreakExtend
+	case 0x1f1e6 <= r && r <= 0x1f1ff:
+		return graphemeBreakRegionalIndicator
+	case 0x1100 <= r && r <= 0x115f, 0xa960 <= r && r <= 0xa97c:
+		return graphemeBreakL
+	case 0x1160 <= r && r <= 0x11a7, 0xd7b0 <= r && r <= 0xd7c6:
+		return graphemeBreakV
+	case 0x11a8 <= r && r <= 0x11ff, 0xd7cb <= r && r <= 0xd7fb:
+		return graphemeBreakT
+	case 0xac00 <= r && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return graphemeBreakLV
+		}
+		return graphemeBreakLVT
+	case unicode.Is(prepend, r):
+		return graphemeBreakPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return graphemeBreakExtend
+	case unicode.Is(unicode.Mc, r):
+		return graphemeBreakSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return graphemeBreakControl
+	case unicode.Is(extendedPictographic, r):
+		return graphemeBreakExtendedPictographic
+	}
+	return graphemeBreakOther
+}
+
+// indicConjunctBreakOf returns the Indic_Conjunct_Break property of r that has the Grapheme_Cluster_Break property gcb.
+// NOTE: InCB=Extend is approximated by Extend and ZWJ of the Grapheme_Cluster_Break property.
+func indicConjunctBreakOf(r rune, gcb graphemeBreak) indicConjunctBreak {
+	switch {
+	case unicode.Is(indicConjunctLinker, r):
+		return indicConjunctBreakLinker
+	case unicode.Is(indicConjunctConsonant, r):
+		return indicConjunctBreakConsonant
+	case gcb == graphemeBreakExtend || gcb == graphemeBreakZWJ:
+		return indicConjunctBreakExtend
+	}
+	return indicConjunctBreakNone
+}
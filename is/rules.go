@@ -20,6 +20,7 @@ type (
 	zeroRule         struct{}
 	nilOrNonZeroRule struct{}
 	anyRule          struct{}
+	deprecatedRule   struct{}
 	neverRule        struct{}
 	urlRule          struct {
 		schemes []string
@@ -62,6 +63,9 @@ var (
 	Zero valis.Rule = &zeroRule{}
 	// NilOrNonZero is a rule to verify nil or non-zero value.
 	NilOrNonZero valis.Rule = &nilOrNonZeroRule{}
+	// Deprecated is a rule that adds a warning when the value is specified (non-zero).
+	// The warning does not fail the validation. See also valis.NewWarning.
+	Deprecated valis.Rule = &deprecatedRule{}
 	// Any is a rule indicating that any value is acceptable.
 	Any valis.Rule = &anyRule{}
 	// Never is a rule indicating that any value is not acceptable.
@@ -94,6 +98,13 @@ func (rule *zeroRule) Validate(validator *valis.Validator, value interface{}) {
 	}
 }
 
+func (rule *deprecatedRule) Validate(validator *valis.Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	if val.IsValid() && !val.IsZero() {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewWarning(code.Deprecated, value))
+	}
+}
+
 func (rule *nilOrNonZeroRule) Validate(validator *valis.Validator, value interface{}) {
 	val := reflect.ValueOf(value)
 	isValid := false
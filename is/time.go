@@ -0,0 +1,131 @@
+package is
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+)
+
+type (
+	timeRangeRule struct {
+		min *time.Time
+		max *time.Time
+	}
+	timeRelationRule struct {
+		code string
+		ok   func(t time.Time) bool
+		args []interface{}
+	}
+	weekdayRule struct {
+		weekdays []time.Weekday
+	}
+	durationRangeRule struct {
+		min time.Duration
+		max time.Duration
+	}
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+var (
+	// InFuture is a rule to verify that the time is after the current time.
+	InFuture valis.Rule = &timeRelationRule{code: code.Future, ok: func(t time.Time) bool { return t.After(time.Now()) }}
+	// InPast is a rule to verify that the time is before the current time.
+	InPast valis.Rule = &timeRelationRule{code: code.Past, ok: func(t time.Time) bool { return t.Before(time.Now()) }}
+)
+
+// Before returns a rule to verify that the time is before t.
+// The value must be time.Time. Use to.Time if you want to verify the string.
+func Before(t time.Time) valis.Rule {
+	return &timeRelationRule{code: code.Before, ok: func(v time.Time) bool { return v.Before(t) }, args: []interface{}{t}}
+}
+
+// After returns a rule to verify that the time is after t.
+// The value must be time.Time. Use to.Time if you want to verify the string.
+func After(t time.Time) valis.Rule {
+	return &timeRelationRule{code: code.After, ok: func(v time.Time) bool { return v.After(t) }, args: []interface{}{t}}
+}
+
+// TimeBetween returns a rule to verify that the time is between min and max (inclusive).
+// The value must be time.Time. Use to.Time if you want to verify the string.
+func TimeBetween(min time.Time, max time.Time) valis.Rule {
+	return &timeRangeRule{min: &min, max: &max}
+}
+
+// Weekday returns a rule to verify that the weekday of the time is included in the weekdays.
+func Weekday(weekdays ...time.Weekday) valis.Rule {
+	return &weekdayRule{weekdays: weekdays}
+}
+
+// DurationBetween returns a rule to verify that the time.Duration is between min and max (inclusive).
+func DurationBetween(min time.Duration, max time.Duration) valis.Rule {
+	return &durationRangeRule{min: min, max: max}
+}
+
+func (rule *timeRelationRule) Validate(validator *valis.Validator, value interface{}) {
+	t, ok := toTime(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotTime, value))
+		return
+	}
+	if !rule.ok(t) {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(rule.code, value, rule.args...))
+	}
+}
+
+func (rule *timeRangeRule) Validate(validator *valis.Validator, value interface{}) {
+	t, ok := toTime(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotTime, value))
+		return
+	}
+	if t.Before(*rule.min) || t.After(*rule.max) {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.TimeOutOfRange, value, *rule.min, *rule.max))
+	}
+}
+
+func (rule *weekdayRule) Validate(validator *valis.Validator, value interface{}) {
+	t, ok := toTime(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotTime, value))
+		return
+	}
+	for _, weekday := range rule.weekdays {
+		if t.Weekday() == weekday {
+			return
+		}
+	}
+	validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Weekday, value, rule.weekdays))
+}
+
+func (rule *durationRangeRule) Validate(validator *valis.Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if !val.IsValid() || val.Type() != durationType {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotDuration, value))
+		return
+	}
+
+	d := time.Duration(val.Int())
+	if d < rule.min || d > rule.max {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.DurationOutOfRange, value, rule.min, rule.max))
+	}
+}
+
+// toTime returns the time.Time, when the value is time.Time or the pointer.
+func toTime(value interface{}) (time.Time, bool) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if !val.IsValid() || val.Type() != timeType || !val.CanInterface() {
+		return time.Time{}, false
+	}
+	return val.Interface().(time.Time), true
+}
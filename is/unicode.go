@@ -0,0 +1,128 @@
+package is
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	runeRule struct {
+		code string
+		ok   func(r rune) bool
+	}
+	normalizedRule struct {
+		form norm.Form
+	}
+	graphemeLengthRule struct {
+		min int
+		max int
+	}
+)
+
+var (
+	// ASCII is a rule to verify that the string contains only ASCII characters.
+	ASCII valis.Rule = &runeRule{code: code.ASCIIOnly, ok: func(r rune) bool {
+		return r <= unicode.MaxASCII
+	}}
+	// Printable is a rule to verify that the string contains only printable characters.
+	// See also unicode.IsPrint.
+	Printable valis.Rule = &runeRule{code: code.PrintableOnly, ok: unicode.IsPrint}
+	// Alphanumeric is a rule to verify that the string contains only Unicode letters and digits.
+	Alphanumeric valis.Rule = &runeRule{code: code.AlphanumericOnly, ok: func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}}
+	// NoControlCharacters is a rule to verify that the string does not contain control characters.
+	// See also unicode.IsControl.
+	NoControlCharacters valis.Rule = &runeRule{code: code.NoControlCharacters, ok: func(r rune) bool {
+		return !unicode.IsControl(r)
+	}}
+	// NFC is a rule to verify that the string is normalized in Unicode Normalization Form C.
+	NFC = Normalized(norm.NFC)
+)
+
+// Normalized returns a rule to verify that the string is normalized in the form.
+func Normalized(form norm.Form) valis.Rule {
+	return &normalizedRule{form: form}
+}
+
+// GraphemeLengthBetween returns a rule to verify the number of grapheme clusters of the string is between min and max.
+// Unlike LengthBetween, it counts user-perceived characters. For example, an emoji flag (e.g. 🇯🇵) is one character.
+//
+// The grapheme clusters are the extended grapheme clusters of UAX #29, but the properties are approximated by
+// the tables of the unicode package and the ranges of Unicode 15.1 (e.g. Extended_Pictographic is approximated by the emoji blocks).
+// So it may count differently from the latest Unicode for the characters assigned or changed later,
+// such as the Indic conjuncts of Myanmar, Khmer and Balinese.
+func GraphemeLengthBetween(min int, max int) valis.Rule {
+	return &graphemeLengthRule{min: min, max: max}
+}
+
+func (rule *runeRule) Validate(validator *valis.Validator, value interface{}) {
+	s, ok := toString(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotString, value))
+		return
+	}
+	for _, r := range s {
+		if !rule.ok(r) {
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(rule.code, value))
+			return
+		}
+	}
+}
+
+func (rule *normalizedRule) Validate(validator *valis.Validator, value interface{}) {
+	s, ok := toString(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotString, value))
+		return
+	}
+	if !rule.form.IsNormalString(s) {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotNormalized, value, formName(rule.form)))
+	}
+}
+
+func (rule *graphemeLengthRule) Validate(validator *valis.Validator, value interface{}) {
+	s, ok := toString(value)
+	if !ok {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotString, value))
+		return
+	}
+
+	length := graphemeLength(s)
+	if length < rule.min {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.TooShortGraphemeLength, value, rule.min))
+	}
+	if length > rule.max {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.TooLongGraphemeLength, value, rule.max))
+	}
+}
+
+// toString returns the string, when the value is string or the pointer.
+func toString(value interface{}) (string, bool) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.String {
+		return "", false
+	}
+	return val.String(), true
+}
+
+func formName(form norm.Form) string {
+	switch form {
+	case norm.NFC:
+		return "NFC"
+	case norm.NFD:
+		return "NFD"
+	case norm.NFKC:
+		return "NFKC"
+	case norm.NFKD:
+		return "NFKD"
+	}
+	return ""
+}
@@ -0,0 +1,412 @@
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/when"
+)
+
+type (
+	typeRule struct {
+		types []string
+	}
+	additionalPropertiesRule struct {
+		properties map[string]*Schema
+		rules      []valis.Rule
+	}
+	// enumRule verifies the value is included in the values.
+	// Unlike is.In, the values may include null.
+	enumRule struct {
+		values []interface{}
+	}
+	notRule struct {
+		rules []valis.Rule
+	}
+	refRule struct {
+		rules []valis.Rule
+	}
+	compiler struct {
+		root *Schema
+		refs map[string]*refRule
+	}
+)
+
+var (
+	// FormatRules are the rules used for the format keyword.
+	// The unknown formats are ignored as annotations.
+	FormatRules = map[string]valis.Rule{
+		"email":    is.Email,
+		"uri":      is.URL(),
+		"uuid":     is.UUID,
+		"ipv4":     is.IPv4,
+		"ipv6":     is.IPv6,
+		"hostname": is.Hostname,
+	}
+)
+
+var (
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// Compile returns a new rule that verifies the value meets the schema.
+//
+// The value is expected to be decoded by encoding/json (e.g. map[string]interface{}), and the errors are the same as other rules.
+// For example, required is compiled into valis.Key, and minLength and maxLength are compiled into is.LengthBetween.
+// The oneOf keyword is verified in the same way as anyOf, and the unsupported keywords are ignored.
+func Compile(schema *Schema) (valis.Rule, error) {
+	c := &compiler{root: schema, refs: make(map[string]*refRule)}
+	rules, err := c.compile(schema)
+	if err != nil {
+		return nil, err
+	}
+	return valis.And(rules...), nil
+}
+
+func (c *compiler) compile(schema *Schema) ([]valis.Rule, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	if schema.Boolean != nil {
+		if *schema.Boolean {
+			return nil, nil
+		}
+		return []valis.Rule{is.Never}, nil
+	}
+
+	rules := make([]valis.Rule, 0)
+	if schema.Ref != "" {
+		rule, err := c.ref(schema.Ref)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if len(schema.Type) > 0 {
+		rules = append(rules, &typeRule{types: schema.Type})
+	}
+	if len(schema.Enum) > 0 {
+		rules = append(rules, newEnumRule(schema.Enum))
+	}
+	if schema.Const != nil || schema.constNull {
+		rules = append(rules, newEnumRule([]interface{}{schema.Const}))
+	}
+
+	// string
+	stringRules := make([]valis.Rule, 0)
+	if schema.MinLength != nil || schema.MaxLength != nil {
+		stringRules = append(stringRules, is.LengthBetween(intOr(schema.MinLength, 0), intOr(schema.MaxLength, math.MaxInt)))
+	}
+	if schema.Pattern != "" {
+		re, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		stringRules = append(stringRules, is.Match(re))
+	}
+	if rule, ok := FormatRules[schema.Format]; ok {
+		stringRules = append(stringRules, rule)
+	}
+	if len(stringRules) > 0 {
+		rules = append(rules, when.IsString(stringRules...))
+	}
+
+	// number
+	numericRules := make([]valis.Rule, 0)
+	if schema.Minimum != nil {
+		numericRules = append(numericRules, is.Min(*schema.Minimum))
+	}
+	if schema.Maximum != nil {
+		numericRules = append(numericRules, is.Max(*schema.Maximum))
+	}
+	if schema.ExclusiveMinimum != nil {
+		numericRules = append(numericRules, is.GreaterThan(*schema.ExclusiveMinimum))
+	}
+	if schema.ExclusiveMaximum != nil {
+		numericRules = append(numericRules, is.LessThan(*schema.ExclusiveMaximum))
+	}
+	if len(numericRules) > 0 {
+		rules = append(rules, when.IsNumeric(numericRules...))
+	}
+
+	// array
+	arrayRules := make([]valis.Rule, 0)
+	if schema.MinItems != nil || schema.MaxItems != nil {
+		arrayRules = append(arrayRules, is.LenBetween(intOr(schema.MinItems, 0), intOr(schema.MaxItems, math.MaxInt)))
+	}
+	if schema.Items != nil {
+		itemRules, err := c.compile(schema.Items)
+		if err != nil {
+			return nil, err
+		}
+		arrayRules = append(arrayRules, valis.Each(itemRules...))
+	}
+	if len(arrayRules) > 0 {
+		rules = append(rules, when.IsSliceOrArray(arrayRules...))
+	}
+
+	// object
+	objectRules := make([]valis.Rule, 0)
+	if schema.MinProperties != nil || schema.MaxProperties != nil {
+		objectRules = append(objectRules, is.LenBetween(intOr(schema.MinProperties, 0), intOr(schema.MaxProperties, math.MaxInt)))
+	}
+	for _, name := range schema.Required {
+		objectRules = append(objectRules, valis.Key(name))
+	}
+	for _, name := range sortedKeys(schema.Properties) {
+		propertyRules, err := c.compile(schema.Properties[name])
+		if err != nil {
+			return nil, err
+		}
+		objectRules = append(objectRules, when.HasKey(name, valis.Key(name, propertyRules...)))
+	}
+	if schema.AdditionalProperties != nil {
+		additionalRules, err := c.compile(schema.AdditionalProperties)
+		if err != nil {
+			return nil, err
+		}
+		objectRules = append(objectRules, &additionalPropertiesRule{properties: schema.Properties, rules: additionalRules})
+	}
+	if len(objectRules) > 0 {
+		rules = append(rules, when.IsMap(objectRules...))
+	}
+
+	// composition
+	for _, sub := range schema.AllOf {
+		subRules, err := c.compile(sub)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, subRules...)
+	}
+	for _, subs := range [][]*Schema{schema.AnyOf, schema.OneOf} {
+		if len(subs) == 0 {
+			continue
+		}
+		orRules := make([]valis.Rule, 0, len(subs))
+		for _, sub := range subs {
+			subRules, err := c.compile(sub)
+			if err != nil {
+				return nil, err
+			}
+			orRules = append(orRules, valis.And(subRules...))
+		}
+		rules = append(rules, valis.Or(orRules...))
+	}
+	if schema.Not != nil {
+		notRules, err := c.compile(schema.Not)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &notRule{rules: notRules})
+	}
+	return rules, nil
+}
+
+// ref returns the rule of the schema referred by the ref.
+// It supports only the references in the same document, such as "#" and "#/$defs/name".
+func (c *compiler) ref(ref string) (valis.Rule, error) {
+	if rule, ok := c.refs[ref]; ok {
+		return rule, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported $ref (%s)", ref)
+	}
+
+	// NOTE: the fragment is percent-decoded, and then each token of the JSON Pointer is unescaped.
+	pointer, err := url.PathUnescape(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid $ref (%s): %w", ref, err)
+	}
+	schema := c.root
+	tokens := strings.Split(pointer, "/")[1:]
+	for i := range tokens {
+		tokens[i] = jsonPointerUnescaper.Replace(tokens[i])
+	}
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case (token == "$defs" || token == "definitions") && i+1 < len(tokens):
+			i++
+			schema = schema.Defs[tokens[i]]
+		case token == "properties" && i+1 < len(tokens):
+			i++
+			schema = schema.Properties[tokens[i]]
+		case token == "items":
+			schema = schema.Items
+		default:
+			schema = nil
+		}
+		if schema == nil {
+			return nil, fmt.Errorf("unresolved $ref (%s)", ref)
+		}
+	}
+
+	// NOTE: it registers the rule before compiling for the recursive schemas.
+	rule := &refRule{}
+	c.refs[ref] = rule
+	rules, err := c.compile(schema)
+	if err != nil {
+		return nil, err
+	}
+	rule.rules = rules
+	return rule, nil
+}
+
+func (rule *typeRule) Validate(validator *valis.Validator, value interface{}) {
+	for _, ty := range rule.types {
+		if isType(ty, value) {
+			return
+		}
+	}
+
+	if len(rule.types) == 1 {
+		switch rule.types[0] {
+		case TypeNull:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotNull, value))
+			return
+		case TypeBoolean:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotBoolean, value))
+			return
+		case TypeObject:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotMap, value))
+			return
+		case TypeArray:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotArray, value))
+			return
+		case TypeNumber:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotNumeric, value))
+			return
+		case TypeString:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotString, value))
+			return
+		case TypeInteger:
+			validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotInteger, value))
+			return
+		}
+	}
+	validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Invalid, value))
+}
+
+func (rule *additionalPropertiesRule) Validate(validator *valis.Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Map {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.NotMap, value))
+		return
+	}
+
+	keys := val.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+	})
+	for _, key := range keys {
+		if _, ok := rule.properties[fmt.Sprintf("%v", key.Interface())]; ok {
+			continue
+		}
+		mapValue := val.MapIndex(key).Interface()
+		validator.DiveMapValue(key.Interface(), func(v *valis.Validator) {
+			valis.And(rule.rules...).Validate(v, mapValue)
+		})
+	}
+}
+
+func (rule *notRule) Validate(validator *valis.Validator, value interface{}) {
+	newValidator := validator.Clone(&valis.CloneOpts{InheritLocation: true})
+	valis.And(rule.rules...).Validate(newValidator, value)
+	if !newValidator.ErrorCollector().HasError() {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Invalid, value))
+	}
+}
+
+func (rule *refRule) Validate(validator *valis.Validator, value interface{}) {
+	for _, r := range rule.rules {
+		r.Validate(validator, value)
+	}
+}
+
+// isType returns true, when the value is the type of JSON Schema.
+func isType(ty string, value interface{}) bool {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+
+	switch ty {
+	case TypeNull:
+		return !val.IsValid()
+	case TypeBoolean:
+		return val.Kind() == reflect.Bool
+	case TypeObject:
+		return val.Kind() == reflect.Map || val.Kind() == reflect.Struct
+	case TypeArray:
+		return val.Kind() == reflect.Slice || val.Kind() == reflect.Array
+	case TypeString:
+		return val.Kind() == reflect.String
+	case TypeNumber:
+		switch val.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+	case TypeInteger:
+		switch val.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		case reflect.Float32, reflect.Float64:
+			return val.Float() == math.Trunc(val.Float())
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newEnumRule returns a new enumRule.
+func newEnumRule(values []interface{}) *enumRule {
+	return &enumRule{values: values}
+}
+
+func (rule *enumRule) Validate(validator *valis.Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+	for _, v := range rule.values {
+		if v == nil {
+			if !val.IsValid() || val.Kind() == reflect.Ptr {
+				return
+			}
+		} else if val.IsValid() && val.CanInterface() && reflect.DeepEqual(v, val.Interface()) {
+			return
+		}
+	}
+	// NOTE: the error has all values including null.
+	validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Inclusion, value, rule.values))
+}
+
+func intOr(i *int, defaultValue int) int {
+	if i == nil {
+		return defaultValue
+	}
+	return *i
+}
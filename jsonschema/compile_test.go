@@ -0,0 +1,39 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/jsonschema"
+)
+
+func ExampleCompile() {
+	var schema jsonschema.Schema
+	if err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 20},
+			"age": {"type": "integer", "minimum": 20}
+		},
+		"required": ["name"]
+	}`), &schema); err != nil {
+		panic(err)
+	}
+
+	rule, err := jsonschema.Compile(&schema)
+	if err != nil {
+		panic(err)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(`{"name": "", "age": 19.5}`), &value); err != nil {
+		panic(err)
+	}
+	fmt.Println(valis.Validate(value, rule))
+
+	// Output:
+	// (not_integer) [key: age] must be any integer
+	// (gte) [key: age] must be greater than or equal to 20
+	// (too_short_length) [key: name] is too short length (minimum is 1 character)
+}
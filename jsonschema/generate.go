@@ -0,0 +1,214 @@
+package jsonschema
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/soranoba/valis"
+	valishelpers "github.com/soranoba/valis/helpers"
+)
+
+type (
+	// TagSchemaHandler is a valis.FieldTagHandler that can describe the constraints of the tag value in JSON Schema.
+	TagSchemaHandler interface {
+		ApplySchema(prop *Property, tagValue string) error
+	}
+)
+
+type (
+	fieldTagRule interface {
+		Key() string
+		TagHandler() valis.FieldTagHandler
+	}
+	generator struct {
+		root  reflect.Type
+		rules []fieldTagRule
+		defs  map[string]*Schema
+		names map[reflect.Type]string
+	}
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Generate returns a JSON Schema of the type.
+//
+// The constraints are generated from the field tags of the rules created by valis.NewFieldTagRule,
+// when the FieldTagHandler implements the TagSchemaHandler. Other rules are ignored.
+// The names of the properties are the same as encoding/json.
+func Generate(ty reflect.Type, rules ...valis.Rule) (*Schema, error) {
+	for ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+
+	g := &generator{
+		root:  ty,
+		defs:  make(map[string]*Schema),
+		names: make(map[reflect.Type]string),
+	}
+	for _, rule := range rules {
+		if r, ok := rule.(fieldTagRule); ok {
+			g.rules = append(g.rules, r)
+		}
+	}
+
+	var (
+		schema *Schema
+		err    error
+	)
+	if ty.Kind() == reflect.Struct {
+		schema, err = g.structSchema(ty)
+	} else {
+		schema, err = g.typeSchema(ty)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Schema = Draft202012
+	if len(g.defs) > 0 {
+		schema.Defs = g.defs
+	}
+	return schema, nil
+}
+
+func (g *generator) typeSchema(ty reflect.Type) (*Schema, error) {
+	if ty == timeType {
+		return &Schema{Type: Types{TypeString}, Format: "date-time"}, nil
+	}
+	if ty.Implements(textMarshalerType) {
+		return &Schema{Type: Types{TypeString}}, nil
+	}
+
+	switch ty.Kind() {
+	case reflect.Ptr:
+		return g.typeSchema(ty.Elem())
+	case reflect.Bool:
+		return &Schema{Type: Types{TypeBoolean}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: Types{TypeInteger}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: Types{TypeInteger}, Minimum: float64Ptr(0)}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{TypeNumber}}, nil
+	case reflect.String:
+		return &Schema{Type: Types{TypeString}}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice:
+		// NOTE: encoding/json encodes []byte as a base64 string.
+		if ty.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: Types{TypeString}}, nil
+		}
+		items, err := g.typeSchema(ty.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: Types{TypeArray}, Items: items}, nil
+	case reflect.Array:
+		items, err := g.typeSchema(ty.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: Types{TypeArray}, Items: items, MinItems: intPtr(ty.Len()), MaxItems: intPtr(ty.Len())}, nil
+	case reflect.Map:
+		values, err := g.typeSchema(ty.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: Types{TypeObject}, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		if ty == g.root {
+			return &Schema{Ref: "#"}, nil
+		}
+		if ty.Name() == "" {
+			return g.structSchema(ty)
+		}
+		if name, ok := g.names[ty]; ok {
+			return &Schema{Ref: "#/$defs/" + name}, nil
+		}
+
+		name := ty.Name()
+		for i := 2; g.defs[name] != nil; i++ {
+			name = ty.Name() + strconv.Itoa(i)
+		}
+		g.names[ty] = name
+		// NOTE: it reserves the name before generating for the recursive types.
+		g.defs[name] = &Schema{}
+
+		schema, err := g.structSchema(ty)
+		if err != nil {
+			return nil, err
+		}
+		g.defs[name] = schema
+		return &Schema{Ref: "#/$defs/" + name}, nil
+	default:
+		return nil, fmt.Errorf("%s is not supported", ty.String())
+	}
+}
+
+func (g *generator) structSchema(ty reflect.Type) (*Schema, error) {
+	schema := &Schema{Type: Types{TypeObject}, Properties: make(map[string]*Schema)}
+
+	// NOTE: the fields of the embedded structs are promoted in the same way as encoding/json.
+	for _, field := range valishelpers.StructFields(ty) {
+		if field.Tag.Get("json") == "-" {
+			continue
+		}
+
+		fieldSchema, err := g.typeSchema(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", ty.String(), field.Name, err)
+		}
+
+		prop := &Property{Field: field, Schema: fieldSchema}
+		for _, rule := range g.rules {
+			tagValue, ok := field.Tag.Lookup(rule.Key())
+			if !ok {
+				continue
+			}
+			if h, ok := rule.TagHandler().(TagSchemaHandler); ok {
+				if err := h.ApplySchema(prop, tagValue); err != nil {
+					return nil, fmt.Errorf("%s.%s: %w (key = %s)", ty.String(), field.Name, err, rule.Key())
+				}
+			}
+		}
+		if field.Type.Kind() == reflect.Ptr && !prop.Required {
+			prop.Schema = nullable(prop.Schema)
+		}
+
+		name := valishelpers.JSONFieldName(&field)
+		schema.Properties[name] = prop.Schema
+		if prop.Required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema, nil
+}
+
+// nullable returns a schema that also accepts null.
+func nullable(schema *Schema) *Schema {
+	switch {
+	case schema.Ref != "":
+		return &Schema{AnyOf: []*Schema{schema, {Type: Types{TypeNull}}}}
+	case len(schema.Type) > 0:
+		schema.Type = append(schema.Type, TypeNull)
+		if len(schema.Enum) > 0 {
+			schema.Enum = append(schema.Enum, nil)
+		}
+	}
+	return schema
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
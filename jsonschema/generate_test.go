@@ -0,0 +1,60 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/soranoba/valis/jsonschema"
+	"github.com/soranoba/valis/tagrule"
+)
+
+func ExampleGenerate() {
+	type User struct {
+		Name  *string `json:"name" required:"true" validate:"min=1,max=20"`
+		Age   int     `json:"age" validate:"gte=20"`
+		Role  string  `json:"role" enums:"admin,member"`
+		Phone string  `json:"phone,omitempty" pattern:"^[0-9]+$"`
+	}
+
+	schema, err := jsonschema.Generate(
+		reflect.TypeOf(User{}),
+		tagrule.Required, tagrule.Validate, tagrule.Enums, tagrule.Pattern,
+	)
+	if err != nil {
+		panic(err)
+	}
+	b, _ := json.MarshalIndent(schema, "", "  ")
+	fmt.Printf("%s\n", b)
+
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "type": "object",
+	//   "properties": {
+	//     "age": {
+	//       "type": "integer",
+	//       "minimum": 20
+	//     },
+	//     "name": {
+	//       "type": "string",
+	//       "minLength": 1,
+	//       "maxLength": 20
+	//     },
+	//     "phone": {
+	//       "type": "string",
+	//       "pattern": "^[0-9]+$"
+	//     },
+	//     "role": {
+	//       "type": "string",
+	//       "enum": [
+	//         "admin",
+	//         "member"
+	//       ]
+	//     }
+	//   },
+	//   "required": [
+	//     "name"
+	//   ]
+	// }
+}
@@ -0,0 +1,157 @@
+// Package jsonschema provides conversions between valis rules and JSON Schema (draft 2020-12).
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+type (
+	// Schema is a JSON Schema document.
+	Schema struct {
+		// Boolean is not nil when the schema is a boolean schema (true or false).
+		// In that case, the other fields are ignored.
+		Boolean *bool `json:"-"`
+
+		Schema      string             `json:"$schema,omitempty"`
+		ID          string             `json:"$id,omitempty"`
+		Ref         string             `json:"$ref,omitempty"`
+		Defs        map[string]*Schema `json:"$defs,omitempty"`
+		Title       string             `json:"title,omitempty"`
+		Description string             `json:"description,omitempty"`
+		Deprecated  bool               `json:"deprecated,omitempty"`
+
+		Type  Types         `json:"type,omitempty"`
+		Enum  []interface{} `json:"enum,omitempty"`
+		Const interface{}   `json:"const,omitempty"`
+		// constNull is true when the decoded const is null.
+		// It is needed, because a nil Const means that the schema does not have the const.
+		constNull bool
+
+		// string
+		MinLength *int   `json:"minLength,omitempty"`
+		MaxLength *int   `json:"maxLength,omitempty"`
+		Pattern   string `json:"pattern,omitempty"`
+		Format    string `json:"format,omitempty"`
+
+		// number
+		Minimum          *float64 `json:"minimum,omitempty"`
+		Maximum          *float64 `json:"maximum,omitempty"`
+		ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+		ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+		// array
+		Items    *Schema `json:"items,omitempty"`
+		MinItems *int    `json:"minItems,omitempty"`
+		MaxItems *int    `json:"maxItems,omitempty"`
+
+		// object
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+		MinProperties        *int               `json:"minProperties,omitempty"`
+		MaxProperties        *int               `json:"maxProperties,omitempty"`
+
+		// composition
+		AllOf []*Schema `json:"allOf,omitempty"`
+		AnyOf []*Schema `json:"anyOf,omitempty"`
+		OneOf []*Schema `json:"oneOf,omitempty"`
+		Not   *Schema   `json:"not,omitempty"`
+	}
+	// Types is a list of the types of JSON Schema.
+	// It is encoded to a string when it has only one type.
+	Types []string
+	// Property is a property of the object, that is created from the struct field.
+	Property struct {
+		Field    reflect.StructField
+		Schema   *Schema
+		Required bool
+	}
+)
+
+// Type names of JSON Schema.
+const (
+	TypeNull    = "null"
+	TypeBoolean = "boolean"
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeNumber  = "number"
+	TypeString  = "string"
+	TypeInteger = "integer"
+)
+
+const (
+	// Draft202012 is the URI of the JSON Schema draft 2020-12.
+	Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+)
+
+// HasType returns true, when the schema has the type.
+// The TypeNumber includes TypeInteger.
+func (s *Schema) HasType(ty string) bool {
+	for _, t := range s.Type {
+		if t == ty || (ty == TypeNumber && t == TypeInteger) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON encodes the Schema.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.Boolean != nil {
+		return json.Marshal(*s.Boolean)
+	}
+	type schema Schema
+	if s.constNull && s.Const == nil {
+		return json.Marshal(&struct {
+			*schema
+			Const json.RawMessage `json:"const"`
+		}{schema: (*schema)(s), Const: json.RawMessage("null")})
+	}
+	return json.Marshal((*schema)(s))
+}
+
+// UnmarshalJSON decodes the Schema from an object or a boolean.
+func (s *Schema) UnmarshalJSON(b []byte) error {
+	var boolean bool
+	if err := json.Unmarshal(b, &boolean); err == nil {
+		*s = Schema{Boolean: &boolean}
+		return nil
+	}
+	type schema Schema
+	if err := json.Unmarshal(b, (*schema)(s)); err != nil {
+		return err
+	}
+
+	var constant struct {
+		Const json.RawMessage `json:"const"`
+	}
+	if err := json.Unmarshal(b, &constant); err != nil {
+		return err
+	}
+	s.constNull = string(constant.Const) == "null"
+	return nil
+}
+
+// MarshalJSON encodes the Types.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON decodes the Types from a string or an array of strings.
+func (t *Types) UnmarshalJSON(b []byte) error {
+	var ty string
+	if err := json.Unmarshal(b, &ty); err == nil {
+		*t = Types{ty}
+		return nil
+	}
+	var types []string
+	if err := json.Unmarshal(b, &types); err != nil {
+		return err
+	}
+	*t = types
+	return nil
+}
@@ -1,10 +1,14 @@
 package valis
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
+
+	"github.com/soranoba/henge/v2"
+	valishelpers "github.com/soranoba/valis/helpers"
 )
 
 type (
@@ -29,6 +33,8 @@ type (
 	}
 	requestLocationNameResolver struct {
 	}
+	jsonPointerLocationNameResolver struct {
+	}
 )
 
 const (
@@ -51,6 +57,19 @@ var (
 	JSONLocationNameResolver LocationNameResolver = &jsonLocationNameResolver{}
 	// RequestLocationNameResolver is a LocationNameResolver that creates LocationNames using the json and query tag
 	RequestLocationNameResolver LocationNameResolver = &requestLocationNameResolver{}
+	// JSONPointerLocationNameResolver is a LocationNameResolver that creates JSON Pointers (RFC 6901) using the json tag.
+	// It is the inverse of ParseJSONPointer.
+	JSONPointerLocationNameResolver LocationNameResolver = &jsonPointerLocationNameResolver{}
+)
+
+const (
+	// LabelTagKey is the key of the tag that has the human-readable name of the field (e.g. `label:"Email address"`).
+	LabelTagKey = "label"
+)
+
+var (
+	jsonPointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
 )
 
 func newRootLocation() *Location {
@@ -96,6 +115,22 @@ func (loc *Location) Key() interface{} {
 	}
 }
 
+// Label returns the label of the field, when the location is the field that has the label tag.
+// When the location is an element of an array, slice or map, it returns the label of the field that has them.
+// See also LabelTagKey.
+func (loc *Location) Label() (string, bool) {
+	for {
+		switch loc.kind {
+		case LocationKindField:
+			return loc.Field().Tag.Lookup(LabelTagKey)
+		case LocationKindIndex, LocationKindMapKey, LocationKindMapValue:
+			loc = loc.parent
+		default:
+			return "", false
+		}
+	}
+}
+
 // FieldLocation returns a new Location that indicates the value at the field in the struct.
 func (loc *Location) FieldLocation(field *reflect.StructField) *Location {
 	return &Location{
@@ -157,8 +192,8 @@ func (r *jsonLocationNameResolver) ResolveLocationName(loc *Location) string {
 		field := loc.Field()
 		name := field.Name
 		if val := field.Tag.Get("json"); val != "" && val != "-" {
-			attrs := strings.Split(val, ",")
-			if len(attrs) > 0 {
+			// NOTE: the name is omitted when the json tag has only the options (e.g. `json:",inline"`).
+			if attrs := strings.Split(val, ","); attrs[0] != "" {
 				name = attrs[0]
 			}
 		}
@@ -182,8 +217,7 @@ func (r *requestLocationNameResolver) ResolveLocationName(loc *Location) string
 		field := loc.Field()
 		name := field.Name
 		if val := field.Tag.Get("json"); val != "" && val != "-" {
-			attrs := strings.Split(val, ",")
-			if len(attrs) > 0 {
+			if attrs := strings.Split(val, ","); attrs[0] != "" {
 				name = attrs[0]
 			}
 		} else if val := field.Tag.Get("query"); val != "" && val != "-" {
@@ -203,3 +237,94 @@ func (r *requestLocationNameResolver) ResolveLocationName(loc *Location) string
 		panic("invalid LocationKind")
 	}
 }
+
+func (r *jsonPointerLocationNameResolver) ResolveLocationName(loc *Location) string {
+	switch loc.Kind() {
+	case LocationKindRoot:
+		return ""
+	case LocationKindField:
+		return r.ResolveLocationName(loc.Parent()) + "/" + jsonPointerEscaper.Replace(valishelpers.JSONFieldName(loc.Field()))
+	case LocationKindIndex:
+		return r.ResolveLocationName(loc.Parent()) + "/" + strconv.Itoa(loc.Index())
+	case LocationKindMapKey, LocationKindMapValue:
+		// NOTE: JSON Pointer can not indicate the key, so it indicates the value of the key instead.
+		return r.ResolveLocationName(loc.Parent()) + "/" + jsonPointerEscaper.Replace(fmt.Sprintf("%v", loc.Key()))
+	default:
+		panic("invalid LocationKind")
+	}
+}
+
+// ParseJSONPointer returns a new Location indicated by the JSON Pointer (RFC 6901) in the value of the type.
+// The field of the struct is found by the json tag in the same way as JSONPointerLocationNameResolver.
+// When the type of the value is an interface, a token that is an array index is considered as an index, otherwise a key of the map.
+func ParseJSONPointer(pointer string, ty reflect.Type) (*Location, error) {
+	loc := newRootLocation()
+	if pointer == "" {
+		return loc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("json pointer must start with '/' (%s)", pointer)
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = jsonPointerUnescaper.Replace(token)
+		for ty != nil && ty.Kind() == reflect.Ptr {
+			ty = ty.Elem()
+		}
+		if ty == nil {
+			return nil, errors.New("invalid type")
+		}
+
+		switch ty.Kind() {
+		case reflect.Struct:
+			field, ok := findJSONField(ty, token)
+			if !ok {
+				return nil, fmt.Errorf("%s does not have the field (%s)", ty.String(), token)
+			}
+			loc = loc.FieldLocation(field)
+			ty = field.Type
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || (len(token) > 1 && token[0] == '0') {
+				return nil, fmt.Errorf("invalid array index (%s)", token)
+			}
+			if ty.Kind() == reflect.Array && index >= ty.Len() {
+				return nil, fmt.Errorf("array index out of range (%s)", token)
+			}
+			loc = loc.IndexLocation(index)
+			ty = ty.Elem()
+		case reflect.Map:
+			key := reflect.New(ty.Key())
+			if err := henge.New(token).Convert(key.Interface()); err != nil {
+				return nil, fmt.Errorf("invalid map key (%s): %w", token, err)
+			}
+			loc = loc.MapValueLocation(key.Elem().Interface())
+			ty = ty.Elem()
+		case reflect.Interface:
+			// NOTE: The type of value is unknown, so it is considered as the value decoded by encoding/json.
+			if index, err := strconv.Atoi(token); err == nil && index >= 0 && (len(token) == 1 || token[0] != '0') {
+				loc = loc.IndexLocation(index)
+			} else {
+				loc = loc.MapValueLocation(token)
+			}
+		default:
+			return nil, fmt.Errorf("%s can not be dived (%s)", ty.String(), token)
+		}
+	}
+	return loc, nil
+}
+
+// findJSONField returns the exported field that has the name in JSON.
+// The fields of the embedded structs are promoted. See also valishelpers.StructFields.
+func findJSONField(ty reflect.Type, name string) (*reflect.StructField, bool) {
+	for _, field := range valishelpers.StructFields(ty) {
+		if field.Tag.Get("json") == "-" {
+			continue
+		}
+		if valishelpers.JSONFieldName(&field) == name {
+			field := field
+			return &field, true
+		}
+	}
+	return nil, false
+}
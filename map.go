@@ -45,7 +45,7 @@ func (rule *keyRule) Validate(validator *Validator, value interface{}) {
 
 	mapValue := val.MapIndex(keyVal)
 	if mapValue.IsValid() && mapValue.CanInterface() {
-		validator.DiveMapKey(rule.key, func(v *Validator) {
+		validator.dive(validator.loc.MapKeyLocation(rule.key), &valueNode{ref: val, key: keyVal}, func(v *Validator) {
 			And(rule.rules...).Validate(v, mapValue.Interface())
 		})
 	} else {
@@ -70,6 +70,9 @@ func (rule *eachKeyRule) Validate(validator *Validator, value interface{}) {
 	}
 
 	for _, keyVal := range val.MapKeys() {
+		if validator.isStopped() {
+			return
+		}
 		k := keyVal.Interface()
 		validator.DiveMapKey(k, func(v *Validator) {
 			And(rule.rules...).Validate(v, k)
@@ -95,7 +98,11 @@ func (rule *eachValueRule) Validate(validator *Validator, value interface{}) {
 
 	iter := val.MapRange()
 	for iter.Next() {
-		validator.DiveMapValue(iter.Key().Interface(), func(v *Validator) {
+		if validator.isStopped() {
+			return
+		}
+		keyVal := iter.Key()
+		validator.dive(validator.loc.MapValueLocation(keyVal.Interface()), &valueNode{ref: val, key: keyVal}, func(v *Validator) {
 			And(rule.rules...).Validate(v, iter.Value().Interface())
 		})
 	}
@@ -0,0 +1,89 @@
+package valis
+
+type (
+	// rewriteRule is a rule that rewrites the errors of the rules.
+	rewriteRule struct {
+		rewrite func(detail *errorDetail)
+		rules   []Rule
+	}
+	rewriteErrorCollector struct {
+		ErrorCollector
+		rewrite func(detail *errorDetail)
+	}
+)
+
+// WithCode returns a CombinationRule that replaces the code and the params of the errors of the rules.
+// The errors at the descendant locations are also replaced.
+//
+// For example,
+//
+//	valis.WithCode("invalid_username_length", 3, 20)(is.LengthBetween(3, 20))
+//
+// The message is translated with the code by the catalog, so you should register the translation of the code.
+func WithCode(code string, params ...interface{}) CombinationRule {
+	return func(rules ...Rule) Rule {
+		return &rewriteRule{
+			rewrite: func(detail *errorDetail) {
+				detail.code, detail.params, detail.message, detail.literal = code, params, "", false
+			},
+			rules: rules,
+		}
+	}
+}
+
+// WithMessage returns a CombinationRule that replaces the message and the params of the errors of the rules.
+// Unlike WithCode, the code of the errors is not changed.
+//
+// For example,
+//
+//	valis.WithMessage("must be %[1]d-%[2]d characters", 3, 20)(is.LengthBetween(3, 20))
+//
+// The msg is used as the key of the catalog, so it is translated when the catalog has the translation of the msg.
+// Otherwise, it is used as the format as it is.
+func WithMessage(msg string, params ...interface{}) CombinationRule {
+	return func(rules ...Rule) Rule {
+		return &rewriteRule{
+			rewrite: func(detail *errorDetail) {
+				detail.message, detail.params, detail.literal = msg, params, false
+			},
+			rules: rules,
+		}
+	}
+}
+
+// WithLiteralMessage is equiv to WithMessage, but the msg is not a format (e.g. a message written in the struct tag).
+// The msg is translated when the catalog has the translation of the msg. Otherwise, it is used as it is, even if it includes `%`.
+func WithLiteralMessage(msg string) CombinationRule {
+	return func(rules ...Rule) Rule {
+		return &rewriteRule{
+			rewrite: func(detail *errorDetail) {
+				detail.message, detail.params, detail.literal = msg, nil, true
+			},
+			rules: rules,
+		}
+	}
+}
+
+func (rule *rewriteRule) Validate(validator *Validator, value interface{}) {
+	errorCollector := &rewriteErrorCollector{ErrorCollector: validator.ErrorCollector(), rewrite: rule.rewrite}
+	newValidator := validator.Clone(&CloneOpts{InheritLocation: true, ErrorCollector: errorCollector})
+	for _, r := range rule.rules {
+		if newValidator.isStopped() {
+			return
+		}
+		r.Validate(newValidator, value)
+	}
+}
+
+func (c *rewriteErrorCollector) Add(loc *Location, err Error) {
+	newDetail := *err
+	c.rewrite(&newDetail)
+	c.ErrorCollector.Add(loc, &newDetail)
+}
+
+func (c *rewriteErrorCollector) IsFull() bool {
+	if errorCollector, ok := c.ErrorCollector.(LimitedErrorCollector); ok {
+		return errorCollector.IsFull()
+	}
+	return false
+}
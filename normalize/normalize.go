@@ -0,0 +1,89 @@
+// Package normalize implements some valis.Rule that write the normalized value back.
+// See also valis.Transform.
+package normalize
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/soranoba/valis"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// Trim is a rule that removes the leading and trailing white spaces of the string.
+	Trim = String(strings.TrimSpace)
+	// Lower is a rule that converts the string to lower case.
+	Lower = String(strings.ToLower)
+	// Upper is a rule that converts the string to upper case.
+	Upper = String(strings.ToUpper)
+	// NFC is a rule that normalizes the string in Unicode Normalization Form C.
+	NFC = String(norm.NFC.String)
+)
+
+// String returns a rule that writes the string converted by f back.
+// When the value is a nil pointer, it does nothing.
+func String(f func(s string) string) valis.Rule {
+	return valis.Transform(func(value interface{}) (interface{}, error) {
+		val := reflect.ValueOf(value)
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return val.Interface(), nil
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.String {
+			return nil, errors.New("can not normalize the value that is not a string")
+		}
+		return f(val.String()), nil
+	})
+}
+
+// Default returns a rule that writes the defaultValue back, when the value is zero or a nil pointer.
+// The defaultValue is converted to the type of the value (or the element type of the pointer).
+func Default(defaultValue interface{}) valis.Rule {
+	defaultVal := reflect.ValueOf(defaultValue)
+	if !defaultVal.IsValid() {
+		panic("defaultValue is an invalid value")
+	}
+	return DefaultFunc(func(ty reflect.Type) (interface{}, error) {
+		return convert(defaultVal, ty)
+	})
+}
+
+// DefaultFunc returns a rule that writes the value returned by f back, when the value is zero or a nil pointer.
+// f receives the type of the value, or the element type when the value is a pointer.
+//
+// Unlike other rules in this package, it does nothing when the value is not zero,
+// so the value does not have to be addressable in that case.
+func DefaultFunc(f func(ty reflect.Type) (interface{}, error)) valis.Rule {
+	isZero := func(ctx *valis.WhenContext) bool {
+		val := reflect.ValueOf(ctx.Value())
+		return val.IsValid() && val.IsZero()
+	}
+	return valis.When(isZero, valis.Transform(func(value interface{}) (interface{}, error) {
+		val := reflect.ValueOf(value)
+		if val.Kind() != reflect.Ptr {
+			return f(val.Type())
+		}
+
+		newValue, err := f(val.Type().Elem())
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(val.Type().Elem())
+		ptr.Elem().Set(reflect.ValueOf(newValue))
+		return ptr.Interface(), nil
+	}))
+}
+
+// convert returns the value converted to the type.
+// NOTE: it does not convert the number to the string, because reflect converts it as a rune.
+func convert(val reflect.Value, ty reflect.Type) (interface{}, error) {
+	if !val.Type().ConvertibleTo(ty) || (ty.Kind() == reflect.String && val.Kind() != reflect.String) {
+		return nil, fmt.Errorf("can not convert from %s to %s", val.Type().String(), ty.String())
+	}
+	return val.Convert(ty).Interface(), nil
+}
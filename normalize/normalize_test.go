@@ -0,0 +1,23 @@
+package normalize
+
+import (
+	"fmt"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+)
+
+func ExampleString() {
+	type User struct {
+		Email string
+	}
+
+	user := User{Email: " Foo@Example.com "}
+	if err := valis.Validate(&user, valis.Field(&user.Email, Trim, Lower, is.In("foo@example.com"))); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(user.Email)
+
+	// Output:
+	// foo@example.com
+}
@@ -0,0 +1,194 @@
+package valis
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/soranoba/valis/code"
+)
+
+type (
+	parallelEachRule struct {
+		rules []Rule
+	}
+	parallelEachValueRule struct {
+		rules []Rule
+	}
+)
+
+// ParallelEach returns a new rule that verifies all elements of the array or slice meet the rules and all common rules.
+// It is equiv to Each, but the elements are verified concurrently by workers. See also Validator.SetConcurrency.
+//
+// The errors are added to the ErrorCollector in order of the index after the elements are verified,
+// so the result is the same as Each even if the ErrorCollector is not thread-safe.
+func ParallelEach(rules ...Rule) Rule {
+	return &parallelEachRule{rules: rules}
+}
+
+func (rule *parallelEachRule) Validate(validator *Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		validator.parallelize(val.Len(), func(v *Validator, i int) {
+			indexVal := val.Index(i)
+			v.dive(v.loc.IndexLocation(i), &valueNode{ref: indexVal}, func(v *Validator) {
+				And(rule.rules...).Validate(v, indexVal.Interface())
+			})
+		})
+	default:
+		validator.ErrorCollector().Add(validator.Location(), NewError(code.NotArray, value))
+	}
+}
+
+// ParallelEachValues returns a new rule that verifies all values of the map meet the rules and all common rules.
+// It is equiv to EachValues, but the values are verified concurrently by workers. See also Validator.SetConcurrency.
+//
+// The errors are added to the ErrorCollector in order of the sorted keys after the values are verified,
+// so the order of errors is deterministic unlike EachValues.
+func ParallelEachValues(rules ...Rule) Rule {
+	return &parallelEachValueRule{rules: rules}
+}
+
+func (rule *parallelEachValueRule) Validate(validator *Validator, value interface{}) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Map {
+		validator.ErrorCollector().Add(validator.Location(), NewError(code.NotMap, value))
+		return
+	}
+
+	keys := val.MapKeys()
+	sortMapKeys(keys)
+	// NOTE: the values are read before the workers start, because the workers may write the transformed values back to the map.
+	mapValues := make([]interface{}, len(keys))
+	for i, key := range keys {
+		mapValues[i] = val.MapIndex(key).Interface()
+	}
+	mapLock := &sync.Mutex{}
+	validator.parallelize(len(keys), func(v *Validator, i int) {
+		v.dive(v.loc.MapValueLocation(keys[i].Interface()), &valueNode{ref: val, key: keys[i], mapLock: mapLock}, func(v *Validator) {
+			And(rule.rules...).Validate(v, mapValues[i])
+		})
+	})
+}
+
+// parallelize calls f with indexes from 0 to n-1 concurrently, and adds the errors to the ErrorCollector in order of the index.
+// Each f is called with a new Validator that has an own ErrorCollector created by the ErrorCollectorFactoryFunc.
+// When f panics, the other workers stop and it panics with the same value on the calling goroutine.
+// The visited of the Validator is copied for each worker, because it is updated while descending.
+func (v *Validator) parallelize(n int, f func(v *Validator, i int)) {
+	workers := v.concurrency
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	// NOTE: it is checked before the workers start, because the workers descend from the same location.
+	if v.isStopped() || !v.enter() {
+		return
+	}
+
+	var (
+		recorders = make([]*recordingErrorCollector, n)
+		next      = int64(-1)
+		numErrors = int64(0)
+		panicked  = int32(0)
+		panicOnce sync.Once
+		panicVal  interface{}
+		wg        sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			visited := make(map[pointerIdentity]int, len(v.visited))
+			for id, n := range v.visited {
+				visited[id] = n
+			}
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicVal = r })
+					atomic.StoreInt32(&panicked, 1)
+				}
+			}()
+			for {
+				// NOTE: The elements are dispatched in order of the index, so the verified elements are always the prefix.
+				// Therefore, the first errors are the same as the sequential version even if it stops on the way.
+				if atomic.LoadInt32(&panicked) != 0 || v.isCanceled() || (v.maxErrors > 0 && atomic.LoadInt64(&numErrors) >= int64(v.maxErrors)) {
+					return
+				}
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+
+				errorCollector, recorder := v.newRecordingErrorCollector()
+				recorders[i] = recorder
+				newValidator := v.Clone(&CloneOpts{InheritLocation: true, ErrorCollector: errorCollector})
+				newValidator.visited = visited
+				f(newValidator, i)
+				atomic.AddInt64(&numErrors, int64(recorder.count(SeverityError)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// NOTE: the panic in the workers is raised on the calling goroutine, in the same way as Each.
+	if atomic.LoadInt32(&panicked) != 0 {
+		panic(panicVal)
+	}
+
+	errorCollector := v.ErrorCollector()
+	for _, recorder := range recorders {
+		if recorder == nil {
+			continue
+		}
+		for _, locErr := range recorder.errors {
+			errorCollector.Add(locErr.Location, locErr.Error)
+		}
+	}
+}
+
+// sortMapKeys sorts the keys of the map in a deterministic order.
+func sortMapKeys(keys []reflect.Value) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		x, y := keys[i], keys[j]
+		for x.Kind() == reflect.Interface || x.Kind() == reflect.Ptr {
+			if x.IsNil() {
+				break
+			}
+			x = x.Elem()
+		}
+		for y.Kind() == reflect.Interface || y.Kind() == reflect.Ptr {
+			if y.IsNil() {
+				break
+			}
+			y = y.Elem()
+		}
+		if x.Kind() == y.Kind() {
+			switch x.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				return x.Int() < y.Int()
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				return x.Uint() < y.Uint()
+			case reflect.Float32, reflect.Float64:
+				return x.Float() < y.Float()
+			case reflect.String:
+				return x.String() < y.String()
+			}
+		}
+		return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+	})
+}
@@ -0,0 +1,154 @@
+package valis
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	valishelpers "github.com/soranoba/valis/helpers"
+)
+
+type (
+	// structPlan is a validation plan of a struct type.
+	// It is compiled once per type and reused by EachFields and Deep.
+	structPlan struct {
+		fields []*fieldPlan
+	}
+	// fieldPlan is a validation plan of a struct field.
+	fieldPlan struct {
+		field reflect.StructField
+		// path is the embedded fields to reach the field, when the field is not promoted. See location.
+		path []reflect.StructField
+		// embedded is true, when the fields of the field are promoted. See valishelpers.FlattenedStructType.
+		embedded bool
+		// resolved has the rules created from the tag of the field per resolvedKey.
+		resolved sync.Map // map[resolvedKey][]Rule
+	}
+	// resolvedKey is the identity of the fieldTagRule.
+	// It is keyed by the tag key and the FieldTagHandler, so that the fieldTagRules created each time share the cache.
+	resolvedKey struct {
+		key        string
+		tagHandler FieldTagHandler
+	}
+	// fieldRules is the rules applied to the fields by EachFields and Deep.
+	// The rules created from the tags are flattened once per fieldPlan, and it is reused.
+	fieldRules struct {
+		rules     []Rule
+		flattened sync.Map // map[*fieldPlan]*andRule
+	}
+)
+
+var (
+	structPlans sync.Map // map[reflect.Type]*structPlan
+)
+
+// loadStructPlan returns the structPlan of the struct type.
+func loadStructPlan(ty reflect.Type) *structPlan {
+	if plan, ok := structPlans.Load(ty); ok {
+		return plan.(*structPlan)
+	}
+
+	// NOTE: all fields including the embedded fields and the fields of them are verified.
+	// The embedded structs are flattened in the same way as encoding/json only in the locations.
+	promoted := make(map[string]bool)
+	for _, field := range valishelpers.StructFields(ty) {
+		promoted[fmt.Sprint(field.Index)] = true
+	}
+	plan := &structPlan{fields: make([]*fieldPlan, 0, ty.NumField())}
+	plan.appendFields(ty, nil, nil, promoted, map[reflect.Type]bool{ty: true})
+
+	actual, _ := structPlans.LoadOrStore(ty, plan)
+	return actual.(*structPlan)
+}
+
+// appendFields appends the fields of the struct type reached by the index and the path, and the fields of the embedded structs.
+// The fields are appended in order of the index. The embedded struct that is any ancestor is not expanded.
+func (plan *structPlan) appendFields(ty reflect.Type, index []int, path []reflect.StructField, promoted map[string]bool, ancestors map[reflect.Type]bool) {
+	for i := 0; i < ty.NumField(); i++ {
+		field := ty.Field(i)
+		field.Index = append(append(make([]int, 0, len(index)+1), index...), i)
+
+		embeddedTy, embedded := valishelpers.FlattenedStructType(&field)
+		// NOTE: unexported fields can not be interfaced, but the exported fields of unexported embedded structs are promoted.
+		if field.PkgPath != "" && !(field.Anonymous && embedded) {
+			continue
+		}
+		fieldPath := path
+		if promoted[fmt.Sprint(field.Index)] {
+			fieldPath = nil
+		}
+		if field.PkgPath == "" {
+			plan.fields = append(plan.fields, &fieldPlan{field: field, path: fieldPath, embedded: embedded})
+		}
+		if embedded && !ancestors[embeddedTy] {
+			ancestors[embeddedTy] = true
+			plan.appendFields(embeddedTy, field.Index, append(fieldPath[:len(fieldPath):len(fieldPath)], field), promoted, ancestors)
+			delete(ancestors, embeddedTy)
+		}
+	}
+}
+
+// location returns the location of the field from the location of the struct.
+// The field promoted from the embedded struct is located as the field of the struct in the same way as encoding/json,
+// and the other fields (e.g. the shadowed fields) are located through the embedded fields.
+func (p *fieldPlan) location(loc *Location) *Location {
+	for i := range p.path {
+		loc = loc.FieldLocation(&p.path[i])
+	}
+	return loc.FieldLocation(&p.field)
+}
+
+// value returns the field value of the struct value.
+// It returns false, when the field is in the embedded struct of a nil pointer.
+func (p *fieldPlan) value(structVal reflect.Value) (reflect.Value, bool) {
+	return valishelpers.FieldByIndex(structVal, p.field.Index)
+}
+
+// resolve returns the rules created from the tag of the field.
+func (p *fieldPlan) resolve(tagRule *fieldTagRule) []Rule {
+	// NOTE: the handlers that can not be compared are not cached, because they can not be the key.
+	if !reflect.TypeOf(tagRule.tagHandler).Comparable() {
+		return tagRule.resolve(&p.field)
+	}
+
+	key := resolvedKey{key: tagRule.key, tagHandler: tagRule.tagHandler}
+	if rules, ok := p.resolved.Load(key); ok {
+		return rules.([]Rule)
+	}
+	rules, _ := p.resolved.LoadOrStore(key, tagRule.resolve(&p.field))
+	return rules.([]Rule)
+}
+
+// newFieldRules returns a new fieldRules.
+func newFieldRules(rules []Rule) *fieldRules {
+	return &fieldRules{rules: rules}
+}
+
+// flatten returns the rule that the rules created from the tag of the field are expanded in place of the fieldTagRule.
+func (r *fieldRules) flatten(p *fieldPlan) *andRule {
+	if rule, ok := r.flattened.Load(p); ok {
+		return rule.(*andRule)
+	}
+
+	rules := make([]Rule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		if tagRule, ok := rule.(*fieldTagRule); ok {
+			rules = append(rules, p.resolve(tagRule)...)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	rule, _ := r.flattened.LoadOrStore(p, &andRule{rules: rules})
+	return rule.(*andRule)
+}
+
+// hasRules returns true, when any rules are applied to the field.
+func (r *fieldRules) hasRules(p *fieldPlan) bool {
+	return len(r.flatten(p).rules) > 0
+}
+
+// validate verifies the field value meets the rules and all common rules.
+// It is equiv to And, but the rules created from the tag are resolved from the plan.
+func (r *fieldRules) validate(validator *Validator, p *fieldPlan, value interface{}) {
+	r.flatten(p).Validate(validator, value)
+}
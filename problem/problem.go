@@ -0,0 +1,103 @@
+// Package problem renders errors as problem details documents (RFC 9457, formerly RFC 7807).
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/soranoba/valis"
+	"golang.org/x/text/message"
+)
+
+type (
+	// Details is a problem details document.
+	// The errors of the ValidationError are stored in the "errors" extension member.
+	Details struct {
+		Type     string
+		Title    string
+		Status   int
+		Detail   string
+		Instance string
+		Errors   []*valis.ErrorEntry
+		// Extensions are additional members of the document.
+		// The members having the same name as the standard members are ignored.
+		Extensions map[string]interface{}
+	}
+)
+
+const (
+	// ContentType is the media type of the problem details document.
+	ContentType = "application/problem+json"
+	// DefaultType is the type used when the Type is empty.
+	DefaultType = "about:blank"
+)
+
+// New returns a new Details of the err.
+//
+// When the err is a valis.ValidationError, the Errors are the Entries translated by the printer.
+// Otherwise, the Detail is the error message.
+// When p is nil, it is translated into English.
+func New(err error, p *message.Printer) *Details {
+	d := &Details{
+		Type:   DefaultType,
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+	}
+
+	var validationErr *valis.ValidationError
+	if errors.As(err, &validationErr) {
+		d.Errors = validationErr.Entries(p)
+	} else if err != nil {
+		d.Detail = err.Error()
+	}
+	return d
+}
+
+// MarshalJSON encodes the Details to a problem details document.
+func (d *Details) MarshalJSON() ([]byte, error) {
+	doc := make(map[string]interface{}, len(d.Extensions)+6)
+	for k, v := range d.Extensions {
+		doc[k] = v
+	}
+
+	doc["type"] = d.Type
+	if d.Type == "" {
+		doc["type"] = DefaultType
+	}
+	for k, v := range map[string]string{"title": d.Title, "detail": d.Detail, "instance": d.Instance} {
+		if v != "" {
+			doc[k] = v
+		} else {
+			delete(doc, k)
+		}
+	}
+	if d.Status != 0 {
+		doc["status"] = d.Status
+	} else {
+		delete(doc, "status")
+	}
+	if d.Errors != nil {
+		doc["errors"] = d.Errors
+	} else {
+		delete(doc, "errors")
+	}
+	return json.Marshal(doc)
+}
+
+// Write writes the Details to the w as the HTTP response.
+func (d *Details) Write(w http.ResponseWriter) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	status := d.Status
+	if status == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,61 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/problem"
+	"github.com/soranoba/valis/translations"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func Example() {
+	type User struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	c := translations.NewCatalog(catalog.Fallback(language.English))
+	for _, f := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(f)
+	}
+
+	u := User{}
+	if err := valis.Validate(
+		&u,
+		valis.Field(&u.Name, is.NonZero),
+		valis.Field(&u.Age, is.Min(20)),
+	); err != nil {
+		d := problem.New(err, message.NewPrinter(language.Japanese, message.Catalog(c)))
+		d.Instance = "/users"
+		b, _ := json.MarshalIndent(d, "", "  ")
+		fmt.Printf("%s\n", b)
+	}
+
+	// Output:
+	// {
+	//   "errors": [
+	//     {
+	//       "pointer": "/name",
+	//       "code": "non_zero",
+	//       "message": "を空白にすることはできません"
+	//     },
+	//     {
+	//       "pointer": "/age",
+	//       "code": "gte",
+	//       "message": "は20以上の値にする必要があります",
+	//       "params": [
+	//         20
+	//       ]
+	//     }
+	//   ],
+	//   "instance": "/users",
+	//   "status": 422,
+	//   "title": "Unprocessable Entity",
+	//   "type": "about:blank"
+	// }
+}
@@ -2,6 +2,7 @@ package valis
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/soranoba/valis/code"
@@ -30,6 +31,16 @@ func NewFieldTagRule(key string, tagHandler FieldTagHandler) *fieldTagRule {
 	return &fieldTagRule{key: key, tagHandler: tagHandler, lock: &sync.RWMutex{}, cache: map[string][]Rule{}}
 }
 
+// Key returns the key of the field tag.
+func (r *fieldTagRule) Key() string {
+	return r.key
+}
+
+// TagHandler returns the FieldTagHandler.
+func (r *fieldTagRule) TagHandler() FieldTagHandler {
+	return r.tagHandler
+}
+
 func (r *fieldTagRule) Validate(validator *Validator, value interface{}) {
 	loc := validator.Location()
 	if loc.Kind() != LocationKindField {
@@ -37,10 +48,23 @@ func (r *fieldTagRule) Validate(validator *Validator, value interface{}) {
 		return
 	}
 
-	field := loc.Field()
+	validator.setValue(value)
+	for _, rule := range r.resolve(loc.Field()) {
+		if validator.isStopped() {
+			return
+		}
+		rule.Validate(validator, value)
+		// NOTE: the value may be transformed by the rule.
+		value = validator.node.value
+	}
+}
+
+// resolve returns the rules created from the tag of the field.
+// When the field does not have the tag, it returns nil.
+func (r *fieldTagRule) resolve(field *reflect.StructField) []Rule {
 	tag, ok := field.Tag.Lookup(r.key)
 	if !ok {
-		return
+		return nil
 	}
 
 	r.lock.RLock()
@@ -58,7 +82,5 @@ func (r *fieldTagRule) Validate(validator *Validator, value interface{}) {
 		r.cache[tag] = rules
 		r.lock.Unlock()
 	}
-	for _, rule := range rules {
-		rule.Validate(validator, value)
-	}
+	return rules
 }
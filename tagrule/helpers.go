@@ -2,13 +2,23 @@ package tagrule
 
 import (
 	"errors"
+	"fmt"
+	"go/token"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/soranoba/henge/v2"
+	"github.com/soranoba/valis/to"
 )
 
 var (
 	errInsufficientNumberOfTagParameters = errors.New("insufficient number of tag parameters")
+	errTooManyTagParameters              = errors.New("too many tag parameters")
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
 )
 
 func SplitAndParseTagValues(s string, sep string, outs ...interface{}) (count int, err error) {
@@ -25,3 +35,102 @@ func SplitAndParseTagValues(s string, sep string, outs ...interface{}) (count in
 	}
 	return count, nil
 }
+
+// validateTagFieldNames returns an error, when the tag value is not the exported Go field names separated by spaces.
+// NOTE: the fields are not found until validation because the handlers do not know the struct.
+func validateTagFieldNames(s string) error {
+	names := strings.Fields(s)
+	if len(names) == 0 {
+		return errInsufficientNumberOfTagParameters
+	}
+	for _, name := range names {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return fmt.Errorf("invalid field name (%s)", name)
+		}
+	}
+	return nil
+}
+
+// parseTagFieldName returns the exported Go field name of the tag value.
+func parseTagFieldName(s string) (string, error) {
+	if err := validateTagFieldNames(s); err != nil {
+		return "", err
+	}
+	names := strings.Fields(s)
+	if len(names) > 1 {
+		return "", errTooManyTagParameters
+	}
+	return names[0], nil
+}
+
+// parseTagTime parses the tag value as RFC3339 or to.DateLayout.
+func parseTagTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errInsufficientNumberOfTagParameters
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Parse(to.DateLayout, s)
+	}
+	return t, nil
+}
+
+// parseTagWeekday parses the tag value as the name of the weekday (e.g. Sunday, Sun).
+func parseTagWeekday(s string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(s, weekday.String()) || strings.EqualFold(s, weekday.String()[:3]) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday (%s)", s)
+}
+
+// parseTagDefault converts the tag value to the type.
+// The time.Duration is parsed by time.ParseDuration, and the elements of the slice are separated by ",".
+func parseTagDefault(s string, ty reflect.Type) (interface{}, error) {
+	switch {
+	case ty == durationType:
+		return time.ParseDuration(s)
+	case ty.Kind() == reflect.Slice:
+		elems := strings.Split(s, ",")
+		slice := reflect.MakeSlice(ty, 0, len(elems))
+		for _, elem := range elems {
+			v, err := parseTagDefault(elem, ty.Elem())
+			if err != nil {
+				return nil, err
+			}
+			slice = reflect.Append(slice, reflect.ValueOf(v))
+		}
+		return slice.Interface(), nil
+	}
+
+	out := reflect.New(ty)
+	if err := henge.New(s).Convert(out.Interface()); err != nil {
+		return nil, err
+	}
+	return out.Elem().Interface(), nil
+}
+
+// copyDefaultValue returns a copy of the value parsed by parseTagDefault,
+// so that the values do not share the same pointers and underlying arrays.
+func copyDefaultValue(val reflect.Value) reflect.Value {
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() {
+			return val
+		}
+		newVal := reflect.New(val.Type().Elem())
+		newVal.Elem().Set(copyDefaultValue(val.Elem()))
+		return newVal
+	case reflect.Slice:
+		if val.IsNil() {
+			return val
+		}
+		newVal := reflect.MakeSlice(val.Type(), val.Len(), val.Len())
+		for i := 0; i < val.Len(); i++ {
+			newVal.Index(i).Set(copyDefaultValue(val.Index(i)))
+		}
+		return newVal
+	}
+	return val
+}
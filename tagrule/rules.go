@@ -2,14 +2,18 @@
 package tagrule
 
 import (
+	"fmt"
 	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/soranoba/henge/v2"
 	"github.com/soranoba/valis"
 	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/normalize"
 	"github.com/soranoba/valis/to"
 	"github.com/soranoba/valis/when"
 )
@@ -19,9 +23,14 @@ type (
 )
 
 type (
-	requiredTagHandler struct{}
-	patternTagHandler  struct{}
-	enumsTagHandler    struct{}
+	requiredTagHandler  struct{}
+	patternTagHandler   struct{}
+	enumsTagHandler     struct{}
+	normalizeTagHandler struct{}
+	defaultTagHandler   struct{}
+	messageRule         struct {
+		rules []valis.Rule
+	}
 )
 
 var (
@@ -49,6 +58,38 @@ var (
 	Enums = valis.NewFieldTagRule("enums", &enumsTagHandler{})
 	// Validate is a `validate` tag rule.
 	Validate = valis.NewFieldTagRule("validate", &ValidateTagHandler{})
+	// Normalize is a `normalize` tag rule.
+	// It writes the normalized value back to the field in order, so it should be placed before the other rules.
+	// See also normalize package.
+	//
+	// For example,
+	//   `normalize:"trim,lower"`
+	//
+	Normalize = valis.NewFieldTagRule("normalize", &normalizeTagHandler{})
+	// Default is a `default` tag rule.
+	// When the field is zero or a nil pointer, it writes the tag value converted to the type of the field back.
+	// The elements of the slice are separated by ",". See also normalize.DefaultFunc.
+	//
+	// For example,
+	//   `default:"10"`
+	//   `default:"1m30s"`
+	//   `default:"a,b"`
+	//
+	Default = valis.NewFieldTagRule("default", &defaultTagHandler{})
+)
+
+const (
+	// MessageTagKey is the key of the tag used by WithMessage.
+	MessageTagKey = "msg"
+)
+
+var (
+	normalizeTagValues = map[string]valis.Rule{
+		"trim":  normalize.Trim,
+		"lower": normalize.Lower,
+		"upper": normalize.Upper,
+		"nfc":   normalize.NFC,
+	}
 )
 
 var (
@@ -62,6 +103,9 @@ var (
 		"zero": func(v string) ([]valis.Rule, error) { // zero
 			return []valis.Rule{is.Zero}, nil
 		},
+		"deprecated": func(v string) ([]valis.Rule, error) { // deprecated
+			return []valis.Rule{is.Deprecated}, nil
+		},
 		"lte": func(v string) ([]valis.Rule, error) { // lte=10
 			var num float64
 			if _, err := SplitAndParseTagValues(v, " ", &num); err != nil {
@@ -98,8 +142,8 @@ var (
 			return []valis.Rule{
 				when.IsNil().
 					ElseWhen(when.IsNumeric(is.Min(min))).
-					ElseWhen(when.IsTypeOrElem(reflect.TypeOf((*string)(nil)), is.LengthBetween(min, math.MaxInt64))).
-					Else(is.LenBetween(min, math.MaxInt64)),
+					ElseWhen(when.IsTypeOrElem(reflect.TypeOf((*string)(nil)), is.LengthBetween(min, math.MaxInt))).
+					Else(is.LenBetween(min, math.MaxInt)),
 			}, nil
 		},
 		"max": func(v string) ([]valis.Rule, error) { // max=10
@@ -138,9 +182,195 @@ var (
 			}
 			return []valis.Rule{when.IsNil().Else(is.URL(strings.Split(v, " ")...))}, nil
 		},
+		"uuid": func(v string) ([]valis.Rule, error) { // uuid
+			return []valis.Rule{when.IsNil().Else(is.UUID)}, nil
+		},
+		"ip": func(v string) ([]valis.Rule, error) { // ip
+			return []valis.Rule{when.IsNil().Else(is.IP)}, nil
+		},
+		"ipv4": func(v string) ([]valis.Rule, error) { // ipv4
+			return []valis.Rule{when.IsNil().Else(is.IPv4)}, nil
+		},
+		"ipv6": func(v string) ([]valis.Rule, error) { // ipv6
+			return []valis.Rule{when.IsNil().Else(is.IPv6)}, nil
+		},
+		"cidr": func(v string) ([]valis.Rule, error) { // cidr
+			return []valis.Rule{when.IsNil().Else(is.CIDR)}, nil
+		},
+		"mac": func(v string) ([]valis.Rule, error) { // mac
+			return []valis.Rule{when.IsNil().Else(is.MAC)}, nil
+		},
+		"hostname": func(v string) ([]valis.Rule, error) { // hostname
+			return []valis.Rule{when.IsNil().Else(is.Hostname)}, nil
+		},
+		"fqdn": func(v string) ([]valis.Rule, error) { // fqdn
+			return []valis.Rule{when.IsNil().Else(is.FQDN)}, nil
+		},
+		"semver": func(v string) ([]valis.Rule, error) { // semver
+			return []valis.Rule{when.IsNil().Else(is.Semver)}, nil
+		},
+		"base64": func(v string) ([]valis.Rule, error) { // base64
+			return []valis.Rule{when.IsNil().Else(is.Base64)}, nil
+		},
+		"hexcolor": func(v string) ([]valis.Rule, error) { // hexcolor
+			return []valis.Rule{when.IsNil().Else(is.HexColor)}, nil
+		},
+		"iso3166_1_alpha2": func(v string) ([]valis.Rule, error) { // iso3166_1_alpha2
+			return []valis.Rule{when.IsNil().Else(is.CountryCode)}, nil
+		},
+		"iso3166_1_alpha3": func(v string) ([]valis.Rule, error) { // iso3166_1_alpha3
+			return []valis.Rule{when.IsNil().Else(is.CountryCodeAlpha3)}, nil
+		},
+		"iso4217": func(v string) ([]valis.Rule, error) { // iso4217
+			return []valis.Rule{when.IsNil().Else(is.CurrencyCode)}, nil
+		},
+		"bcp47_language_tag": func(v string) ([]valis.Rule, error) { // bcp47_language_tag
+			return []valis.Rule{when.IsNil().Else(is.LanguageTag)}, nil
+		},
+		"before": func(v string) ([]valis.Rule, error) { // before=2006-01-02
+			t, err := parseTagTime(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{when.IsNil().Else(to.Time(is.Before(t)))}, nil
+		},
+		"after": func(v string) ([]valis.Rule, error) { // after=2006-01-02T15:04:05Z
+			t, err := parseTagTime(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{when.IsNil().Else(to.Time(is.After(t)))}, nil
+		},
+		"future": func(v string) ([]valis.Rule, error) { // future
+			return []valis.Rule{when.IsNil().Else(to.Time(is.InFuture))}, nil
+		},
+		"past": func(v string) ([]valis.Rule, error) { // past
+			return []valis.Rule{when.IsNil().Else(to.Time(is.InPast))}, nil
+		},
+		"weekday": func(v string) ([]valis.Rule, error) { // weekday=Mon Tue
+			elems := strings.Fields(v)
+			if len(elems) == 0 {
+				return nil, errInsufficientNumberOfTagParameters
+			}
+			weekdays := make([]time.Weekday, 0, len(elems))
+			for _, elem := range elems {
+				weekday, err := parseTagWeekday(elem)
+				if err != nil {
+					return nil, err
+				}
+				weekdays = append(weekdays, weekday)
+			}
+			return []valis.Rule{when.IsNil().Else(to.Time(is.Weekday(weekdays...)))}, nil
+		},
+		"duration": func(v string) ([]valis.Rule, error) { // duration=1s 1h
+			var min, max time.Duration = 0, math.MaxInt64
+			elems := strings.Fields(v)
+			if len(elems) > 2 {
+				return nil, errTooManyTagParameters
+			}
+			for i, elem := range elems {
+				d, err := time.ParseDuration(elem)
+				if err != nil {
+					return nil, err
+				}
+				if i == 0 {
+					min = d
+				} else {
+					max = d
+				}
+			}
+			return []valis.Rule{when.IsNil().Else(is.DurationBetween(min, max))}, nil
+		},
+		"eqfield": func(v string) ([]valis.Rule, error) { // eqfield=Password
+			name, err := parseTagFieldName(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.EqualToField(name)}, nil
+		},
+		"nefield": func(v string) ([]valis.Rule, error) { // nefield=OldPassword
+			name, err := parseTagFieldName(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.NotEqualToField(name)}, nil
+		},
+		"gtfield": func(v string) ([]valis.Rule, error) { // gtfield=StartDate
+			name, err := parseTagFieldName(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.GreaterThanField(name)}, nil
+		},
+		"ltfield": func(v string) ([]valis.Rule, error) { // ltfield=EndDate
+			name, err := parseTagFieldName(v)
+			if err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.LessThanField(name)}, nil
+		},
+		"required_if": func(v string) ([]valis.Rule, error) { // required_if=Role admin owner
+			elems := strings.Fields(v)
+			if len(elems) < 2 {
+				return nil, errInsufficientNumberOfTagParameters
+			}
+			if err := validateTagFieldNames(elems[0]); err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.RequiredIf(elems[0], henge.New(elems[1:]).Slice().Value()...)}, nil
+		},
+		"required_with": func(v string) ([]valis.Rule, error) { // required_with=Email Phone
+			if err := validateTagFieldNames(v); err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.RequiredWith(strings.Fields(v)...)}, nil
+		},
+		"required_without": func(v string) ([]valis.Rule, error) { // required_without=Email Phone
+			if err := validateTagFieldNames(v); err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.RequiredWithout(strings.Fields(v)...)}, nil
+		},
+		"excluded_if": func(v string) ([]valis.Rule, error) { // excluded_if=Role guest
+			elems := strings.Fields(v)
+			if len(elems) < 2 {
+				return nil, errInsufficientNumberOfTagParameters
+			}
+			if err := validateTagFieldNames(elems[0]); err != nil {
+				return nil, err
+			}
+			return []valis.Rule{is.ExcludedIf(elems[0], henge.New(elems[1:]).Slice().Value()...)}, nil
+		},
 	}
 )
 
+// WithMessage returns a new rule that replaces the messages of the errors of the rules with the `msg` tag of the field.
+// When the field does not have the tag, the messages are not replaced. See also valis.WithLiteralMessage.
+//
+// For example,
+//
+//	valis.EachFields(tagrule.WithMessage(tagrule.Required, tagrule.Validate))
+//	`validate:"min=3,max=20" msg:"must be 3-20 characters"`
+//
+// The message is used as the key of the catalog, so it is translated when the catalog has the translation.
+// Otherwise, it is used as it is, even if it includes `%`.
+func WithMessage(rules ...valis.Rule) valis.Rule {
+	return &messageRule{rules: rules}
+}
+
+func (rule *messageRule) Validate(validator *valis.Validator, value interface{}) {
+	loc := validator.Location()
+	if loc.Kind() == valis.LocationKindField {
+		if msg, ok := loc.Field().Tag.Lookup(MessageTagKey); ok {
+			valis.WithLiteralMessage(msg)(rule.rules...).Validate(validator, value)
+			return
+		}
+	}
+	for _, r := range rule.rules {
+		r.Validate(validator, value)
+	}
+}
+
 func (h *requiredTagHandler) ParseTagValue(tagValue string) ([]valis.Rule, error) {
 	ok, _ := strconv.ParseBool(tagValue)
 	if ok {
@@ -188,3 +418,35 @@ func (h *enumsTagHandler) ParseTagValue(tagValue string) ([]valis.Rule, error) {
 	elems := henge.New(strings.Split(tagValue, ",")).Slice().Value()
 	return []valis.Rule{when.IsNil().Else(to.String(is.In(elems...)))}, nil
 }
+
+func (h *normalizeTagHandler) ParseTagValue(tagValue string) ([]valis.Rule, error) {
+	rules := make([]valis.Rule, 0)
+	for _, elem := range strings.Split(tagValue, ",") {
+		rule, ok := normalizeTagValues[elem]
+		if !ok {
+			return nil, fmt.Errorf("unknown normalizer (%s)", elem)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (h *defaultTagHandler) ParseTagValue(tagValue string) ([]valis.Rule, error) {
+	if tagValue == "" {
+		return nil, errInsufficientNumberOfTagParameters
+	}
+
+	// NOTE: the tag value is parsed once per the type of the value, and the parsed value is copied to each value.
+	var defaultValues sync.Map // map[reflect.Type]reflect.Value
+	return []valis.Rule{normalize.DefaultFunc(func(ty reflect.Type) (interface{}, error) {
+		if defaultVal, ok := defaultValues.Load(ty); ok {
+			return copyDefaultValue(defaultVal.(reflect.Value)).Interface(), nil
+		}
+		defaultValue, err := parseTagDefault(tagValue, ty)
+		if err != nil {
+			return nil, err
+		}
+		defaultValues.Store(ty, reflect.ValueOf(defaultValue))
+		return copyDefaultValue(reflect.ValueOf(defaultValue)).Interface(), nil
+	})}, nil
+}
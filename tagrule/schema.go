@@ -0,0 +1,241 @@
+package tagrule
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/soranoba/valis/jsonschema"
+)
+
+const (
+	semverPattern   = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	hexColorPattern = "^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"
+)
+
+var (
+	validateTagSubKeySchemas = map[string]func(prop *jsonschema.Property, v string) error{
+		"required": func(prop *jsonschema.Property, v string) error { // required
+			prop.Required = true
+			return nil
+		},
+		"nonzero": func(prop *jsonschema.Property, v string) error { // nonzero
+			prop.Required = true
+			switch {
+			case prop.Schema.HasType(jsonschema.TypeNumber):
+				prop.Schema.Not = &jsonschema.Schema{Const: 0}
+			case prop.Schema.HasType(jsonschema.TypeBoolean):
+				prop.Schema.Const = true
+			default:
+				applyMin(prop.Schema, 1)
+			}
+			return nil
+		},
+		"deprecated": func(prop *jsonschema.Property, v string) error { // deprecated
+			prop.Schema.Deprecated = true
+			return nil
+		},
+		"lte": func(prop *jsonschema.Property, v string) error { // lte=10
+			var num float64
+			if _, err := SplitAndParseTagValues(v, " ", &num); err != nil {
+				return err
+			}
+			prop.Schema.Maximum = &num
+			return nil
+		},
+		"lt": func(prop *jsonschema.Property, v string) error { // lt=10
+			var num float64
+			if _, err := SplitAndParseTagValues(v, " ", &num); err != nil {
+				return err
+			}
+			prop.Schema.ExclusiveMaximum = &num
+			return nil
+		},
+		"gte": func(prop *jsonschema.Property, v string) error { // gte=10
+			var num float64
+			if _, err := SplitAndParseTagValues(v, " ", &num); err != nil {
+				return err
+			}
+			prop.Schema.Minimum = &num
+			return nil
+		},
+		"gt": func(prop *jsonschema.Property, v string) error { // gt=10
+			var num float64
+			if _, err := SplitAndParseTagValues(v, " ", &num); err != nil {
+				return err
+			}
+			prop.Schema.ExclusiveMinimum = &num
+			return nil
+		},
+		"min": func(prop *jsonschema.Property, v string) error { // min=1
+			var min int
+			if _, err := SplitAndParseTagValues(v, " ", &min); err != nil {
+				return err
+			}
+			applyMin(prop.Schema, min)
+			return nil
+		},
+		"max": func(prop *jsonschema.Property, v string) error { // max=10
+			var max int
+			if _, err := SplitAndParseTagValues(v, " ", &max); err != nil {
+				return err
+			}
+			applyMax(prop.Schema, max)
+			return nil
+		},
+		"len": func(prop *jsonschema.Property, v string) error { // len=10
+			var length int
+			if _, err := SplitAndParseTagValues(v, " ", &length); err != nil {
+				return err
+			}
+			applyMin(prop.Schema, length)
+			applyMax(prop.Schema, length)
+			return nil
+		},
+		"oneof": func(prop *jsonschema.Property, v string) error { // oneof=1 2
+			if v == "" {
+				return errInsufficientNumberOfTagParameters
+			}
+			return applyEnum(prop.Schema, strings.Split(v, " "))
+		},
+		"url": func(prop *jsonschema.Property, v string) error { // url=http https
+			prop.Schema.Format = "uri"
+			return nil
+		},
+		"uuid": func(prop *jsonschema.Property, v string) error { // uuid
+			prop.Schema.Format = "uuid"
+			return nil
+		},
+		"ipv4": func(prop *jsonschema.Property, v string) error { // ipv4
+			prop.Schema.Format = "ipv4"
+			return nil
+		},
+		"ipv6": func(prop *jsonschema.Property, v string) error { // ipv6
+			prop.Schema.Format = "ipv6"
+			return nil
+		},
+		"hostname": func(prop *jsonschema.Property, v string) error { // hostname
+			prop.Schema.Format = "hostname"
+			return nil
+		},
+		"fqdn": func(prop *jsonschema.Property, v string) error { // fqdn
+			prop.Schema.Format = "hostname"
+			return nil
+		},
+		"ip": func(prop *jsonschema.Property, v string) error { // ip
+			prop.Schema.AnyOf = append(prop.Schema.AnyOf, &jsonschema.Schema{Format: "ipv4"}, &jsonschema.Schema{Format: "ipv6"})
+			return nil
+		},
+		"semver": func(prop *jsonschema.Property, v string) error { // semver
+			prop.Schema.Pattern = semverPattern
+			return nil
+		},
+		"hexcolor": func(prop *jsonschema.Property, v string) error { // hexcolor
+			prop.Schema.Pattern = hexColorPattern
+			return nil
+		},
+		// NOTE: cidr, mac, base64, iso3166_1_alpha2, iso3166_1_alpha3, iso4217 and bcp47_language_tag are not mapped,
+		// because neither the formats nor a pattern of JSON Schema can express them exactly.
+	}
+)
+
+// ApplySchema implements jsonschema.TagSchemaHandler.
+func (h *requiredTagHandler) ApplySchema(prop *jsonschema.Property, tagValue string) error {
+	if ok, _ := strconv.ParseBool(tagValue); ok {
+		prop.Required = true
+	}
+	return nil
+}
+
+// ApplySchema implements jsonschema.TagSchemaHandler.
+func (h *ValidateTagHandler) ApplySchema(prop *jsonschema.Property, tagValue string) error {
+	for _, elem := range strings.Split(tagValue, ",") {
+		if elem == "-" {
+			return nil
+		}
+
+		subKv := strings.SplitN(elem, "=", 2)
+		if f, ok := validateTagSubKeySchemas[subKv[0]]; ok {
+			subKey := ""
+			if len(subKv) == 2 {
+				subKey = subKv[1]
+			}
+			if err := f(prop, subKey); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ApplySchema implements jsonschema.TagSchemaHandler.
+func (h *patternTagHandler) ApplySchema(prop *jsonschema.Property, tagValue string) error {
+	if tagValue == "" {
+		return errInsufficientNumberOfTagParameters
+	}
+	prop.Schema.Pattern = tagValue
+	return nil
+}
+
+// ApplySchema implements jsonschema.TagSchemaHandler.
+func (h *enumsTagHandler) ApplySchema(prop *jsonschema.Property, tagValue string) error {
+	if tagValue == "" {
+		return errInsufficientNumberOfTagParameters
+	}
+	return applyEnum(prop.Schema, strings.Split(tagValue, ","))
+}
+
+func applyMin(schema *jsonschema.Schema, min int) {
+	switch {
+	case schema.HasType(jsonschema.TypeNumber):
+		f := float64(min)
+		schema.Minimum = &f
+	case schema.HasType(jsonschema.TypeString):
+		schema.MinLength = &min
+	case schema.HasType(jsonschema.TypeArray):
+		schema.MinItems = &min
+	case schema.HasType(jsonschema.TypeObject):
+		schema.MinProperties = &min
+	}
+}
+
+func applyMax(schema *jsonschema.Schema, max int) {
+	switch {
+	case schema.HasType(jsonschema.TypeNumber):
+		f := float64(max)
+		schema.Maximum = &f
+	case schema.HasType(jsonschema.TypeString):
+		schema.MaxLength = &max
+	case schema.HasType(jsonschema.TypeArray):
+		schema.MaxItems = &max
+	case schema.HasType(jsonschema.TypeObject):
+		schema.MaxProperties = &max
+	}
+}
+
+// applyEnum sets the elems converted to the type of the schema to the enum.
+func applyEnum(schema *jsonschema.Schema, elems []string) error {
+	enum := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		var (
+			value interface{}
+			err   error
+		)
+		switch {
+		case schema.HasType(jsonschema.TypeInteger):
+			value, err = henge.New(elem).Int().Result()
+		case schema.HasType(jsonschema.TypeNumber):
+			value, err = henge.New(elem).Float().Result()
+		case schema.HasType(jsonschema.TypeBoolean):
+			value, err = strconv.ParseBool(elem)
+		default:
+			value = elem
+		}
+		if err != nil {
+			return err
+		}
+		enum = append(enum, value)
+	}
+	schema.Enum = enum
+	return nil
+}
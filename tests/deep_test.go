@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/tagrule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeep(t *testing.T) {
+	assert := assert.New(t)
+
+	type Tag struct {
+		Name string `validate:"nonzero"`
+	}
+	type Company struct {
+		Name *string `required:"true"`
+	}
+	type User struct {
+		Name     string `validate:"nonzero"`
+		Company  *Company
+		Tags     []Tag
+		Labels   map[string]*Tag
+		Extra    interface{}
+		Friends  [1]*User
+		unexport string
+	}
+
+	name := "soranoba"
+	u := &User{
+		Name:    name,
+		Company: &Company{},
+		Tags:    []Tag{{Name: "a"}, {}},
+		Labels:  map[string]*Tag{"x": {}},
+		Extra:   &Tag{},
+		Friends: [1]*User{{Name: "friend", Company: &Company{Name: &name}}},
+	}
+	assert.EqualError(
+		v.Validate(u, valis.Deep(tagrule.Required, tagrule.Validate)),
+		"(required) .Company.Name is required\n"+
+			"(non_zero) .Tags[1].Name can't be blank (or zero)\n"+
+			"(non_zero) .Labels[x].Name can't be blank (or zero)\n"+
+			"(non_zero) .Extra.Name can't be blank (or zero)",
+	)
+
+	// common rules are applied to all values
+	v := valis.NewValidator()
+	v.SetCommonRules(valis.When(func(ctx *valis.WhenContext) bool {
+		_, ok := ctx.Value().(string)
+		return ok
+	}, is.NonZero))
+	assert.EqualError(
+		v.Validate(&Tag{}, valis.Deep()),
+		"(non_zero) .Name can't be blank (or zero)",
+	)
+	assert.EqualError(
+		v.Validate([]map[string]string{{"a": ""}}, valis.Deep()),
+		"(non_zero) [0][a] can't be blank (or zero)",
+	)
+}
+
+func TestDeep_cycle(t *testing.T) {
+	assert := assert.New(t)
+
+	type Node struct {
+		Name     string `validate:"nonzero"`
+		Next     *Node
+		Children []interface{}
+	}
+
+	n := &Node{}
+	n.Next = n
+	n.Children = []interface{}{n, n.Children}
+	assert.EqualError(
+		v.Validate(n, valis.Deep(tagrule.Validate)),
+		"(non_zero) .Name can't be blank (or zero)\n"+
+			"(circular_reference) .Next is a circular reference\n"+
+			"(circular_reference) .Children[0] is a circular reference",
+	)
+
+	m := map[string]interface{}{}
+	m["self"] = m
+	assert.EqualError(
+		v.Validate(m, valis.Deep(is.Any)),
+		"(circular_reference) [self] is a circular reference",
+	)
+
+	// the same pointer in different locations is not a cycle
+	shared := &Node{}
+	assert.EqualError(
+		v.Validate(&Node{Name: "root", Children: []interface{}{shared, shared}}, valis.Deep(tagrule.Validate)),
+		"(non_zero) .Children[0].Name can't be blank (or zero)\n"+
+			"(non_zero) .Children[1].Name can't be blank (or zero)",
+	)
+}
+
+func TestDeep_MaxDepth(t *testing.T) {
+	assert := assert.New(t)
+
+	type Node struct {
+		Name string `validate:"nonzero"`
+		Next *Node
+	}
+
+	n := &Node{Name: "0", Next: &Node{Name: "1", Next: &Node{Next: &Node{}}}}
+	v := valis.NewValidator()
+	assert.EqualError(
+		v.Validate(n, valis.Deep(tagrule.Validate)),
+		"(non_zero) .Next.Next.Name can't be blank (or zero)\n"+
+			"(non_zero) .Next.Next.Next.Name can't be blank (or zero)",
+	)
+
+	// NOTE: Deep uses the max depth of the Validator, in the same way as the common rules.
+	v.SetMaxDepth(2)
+	assert.EqualError(
+		v.Validate(n, valis.Deep(tagrule.Validate)),
+		"(max_depth) .Next.Next.Name exceeds the maximum depth (2)\n"+
+			"(max_depth) .Next.Next.Next exceeds the maximum depth (2)",
+	)
+	v.SetMaxDepth(3)
+	assert.EqualError(
+		v.Validate(n, valis.Deep(tagrule.Validate)),
+		"(non_zero) .Next.Next.Name can't be blank (or zero)\n"+
+			"(max_depth) .Next.Next.Next.Name exceeds the maximum depth (3)\n"+
+			"(max_depth) .Next.Next.Next.Next exceeds the maximum depth (3)",
+	)
+	v.SetMaxDepth(0)
+	assert.EqualError(
+		v.Validate(n, valis.Deep(tagrule.Validate)),
+		"(non_zero) .Next.Next.Name can't be blank (or zero)\n"+
+			"(non_zero) .Next.Next.Next.Name can't be blank (or zero)",
+	)
+
+	// NOTE: the invalid value below the max depth fails the validation.
+	type N struct {
+		Name string `required:"true"`
+		Next *N
+	}
+	root := &N{Name: "0"}
+	last := root
+	for i := 1; i <= 40; i++ {
+		last.Next = &N{Name: fmt.Sprint(i)}
+		last = last.Next
+	}
+	last.Name = ""
+	v.SetMaxDepth(32)
+	err := v.Validate(root, valis.Deep(tagrule.Required))
+	if assert.IsType(&valis.ValidationError{}, err) {
+		errors := err.(*valis.ValidationError).Errors()
+		if assert.Len(errors, 2) {
+			assert.Equal(code.MaxDepthExceeded, errors[0].Error.Code())
+			assert.Equal(strings.Repeat(".Next", 32)+".Name", valis.DefaultLocationNameResolver.ResolveLocationName(errors[0].Location))
+			assert.Equal(code.MaxDepthExceeded, errors[1].Error.Code())
+			assert.Equal(strings.Repeat(".Next", 33), valis.DefaultLocationNameResolver.ResolveLocationName(errors[1].Location))
+		}
+	}
+}
+
+func TestDeep_transform(t *testing.T) {
+	assert := assert.New(t)
+
+	type Tag struct {
+		Name string `normalize:"trim" validate:"nonzero"`
+	}
+	type Post struct {
+		Tags []*Tag
+	}
+
+	p := &Post{Tags: []*Tag{{Name: " go "}, {Name: "  "}}}
+	assert.EqualError(
+		v.Validate(p, valis.Deep(tagrule.Normalize, tagrule.Validate)),
+		"(non_zero) .Tags[1].Name can't be blank (or zero)",
+	)
+	assert.Equal("go", p.Tags[0].Name)
+}
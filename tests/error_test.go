@@ -1,8 +1,17 @@
 package tests
 
 import (
-	"github.com/soranoba/valis"
+	"encoding/json"
+	"errors"
 	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/translations"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
 )
 
 func TestValidationError(t *testing.T) {
@@ -23,3 +32,171 @@ func TestValidationError(t *testing.T) {
 //		valis.NewErrorDetails(is.Required, "", errors.New("cannot be blank")).Error(),
 //	)
 //}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	err := valis.Validate(&User{}, valis.EachFields(is.NonZero), valis.To(func(interface{}) (interface{}, error) {
+		return nil, errors.New("can't convert")
+	}))
+	b, jsonErr := json.Marshal(err)
+	if assert.NoError(jsonErr) {
+		assert.JSONEq(
+			`[`+
+				`{"pointer":"/name","code":"non_zero","message":"can't be blank (or zero)"},`+
+				`{"pointer":"/age","code":"non_zero","message":"can't be blank (or zero)"},`+
+				`{"pointer":"","code":"conversion","message":"can't convert","params":["can't convert"]}`+
+				`]`,
+			string(b),
+		)
+	}
+}
+
+func TestValidationError_TranslateBySeverity(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name     string `json:"name"`
+		Nickname string `json:"nickname"`
+	}
+
+	c := translations.NewCatalog()
+	c.Set(translations.DefaultEnglish)
+
+	user := User{Nickname: "taro"}
+	err := valis.Validate(&user, valis.Field(&user.Name, is.NonZero), valis.Field(&user.Nickname, is.Deprecated))
+	if assert.IsType(&valis.ValidationError{}, err) {
+		validationErr := err.(*valis.ValidationError)
+		assert.Len(validationErr.Details(), 2)
+		assert.Len(validationErr.Errors(), 1)
+		assert.Len(validationErr.Warnings(), 1)
+		assert.Equal(
+			map[valis.Severity]map[string][]string{
+				valis.SeverityError:   {".Name": {"can't be blank (or zero)"}},
+				valis.SeverityWarning: {".Nickname": {"is deprecated"}},
+			},
+			validationErr.TranslateBySeverity(message.NewPrinter(language.English, message.Catalog(c))),
+		)
+	}
+
+	b, jsonErr := json.Marshal(err)
+	if assert.NoError(jsonErr) {
+		assert.JSONEq(
+			`[`+
+				`{"pointer":"/name","code":"non_zero","message":"can't be blank (or zero)"},`+
+				`{"pointer":"/nickname","code":"deprecated","message":"is deprecated","severity":"warning"}`+
+				`]`,
+			string(b),
+		)
+	}
+}
+
+func TestValidationError_TranslateAcceptLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name string `json:"name"`
+	}
+
+	c := translations.NewCatalog()
+	for _, f := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(f)
+	}
+
+	user := User{}
+	err := valis.Validate(&user, valis.Field(&user.Name, is.NonZero))
+	if assert.IsType(&valis.ValidationError{}, err) {
+		validationErr := err.(*valis.ValidationError)
+		assert.Equal(
+			map[string][]string{".Name": {"を空白にすることはできません"}},
+			validationErr.TranslateAcceptLanguage(c, "fr-CH;q=0.5, ja;q=0.9"),
+		)
+		assert.Equal(
+			map[string][]string{".Name": {"não pode ficar em branco (ou zero)"}},
+			validationErr.TranslateAcceptLanguage(c, "pt-PT, pt;q=0.9"),
+		)
+		assert.Equal(
+			map[string][]string{".Name": {"can't be blank (or zero)"}},
+			validationErr.TranslateAcceptLanguage(c, "xx, invalid;;"),
+		)
+	}
+}
+
+func TestValidationError_TranslateWithLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Email string   `json:"email" label:"Email address"`
+		Age   int      `json:"age" label:"Age"`
+		Tags  []string `json:"tags" label:"Tags"`
+		Note  string   `json:"note"`
+	}
+
+	c := translations.NewCatalog()
+	for _, f := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(f)
+	}
+	c.Catalog.(*catalog.Builder).SetString(language.Japanese, translations.LabelKey("Email address"), "メールアドレス")
+	c.Catalog.(*catalog.Builder).SetString(language.Japanese, translations.LabelKey("Age"), "年齢")
+
+	user := User{Tags: []string{""}}
+	err := valis.Validate(&user,
+		valis.Field(&user.Email, is.NonZero),
+		valis.Field(&user.Age, is.Min(20)),
+		valis.Field(&user.Tags, valis.Each(is.NonZero)),
+		valis.Field(&user.Note, is.NonZero),
+	)
+	if assert.IsType(&valis.ValidationError{}, err) {
+		validationErr := err.(*valis.ValidationError)
+		assert.Equal(
+			map[string][]string{
+				".Email":   {"Email address can't be blank (or zero)"},
+				".Age":     {"Age must be greater than or equal to 20"},
+				".Tags[0]": {"Tags can't be blank (or zero)"},
+				".Note":    {"can't be blank (or zero)"},
+			},
+			validationErr.TranslateWithLabels(message.NewPrinter(language.English, message.Catalog(c))),
+		)
+		assert.Equal(
+			map[string][]string{
+				".Email":   {"メールアドレスを空白にすることはできません"},
+				".Age":     {"年齢は20以上の値にする必要があります"},
+				".Tags[0]": {"Tagsを空白にすることはできません"},
+				".Note":    {"を空白にすることはできません"},
+			},
+			validationErr.TranslateWithLabels(message.NewPrinter(language.Japanese, message.Catalog(c))),
+		)
+		// NOTE: the catalog that does not have the template uses the English template.
+		assert.Equal(
+			map[string][]string{
+				".Email":   {"Email address non_zero"},
+				".Age":     {"Age gte"},
+				".Tags[0]": {"Tags non_zero"},
+				".Note":    {"non_zero"},
+			},
+			validationErr.TranslateWithLabels(message.NewPrinter(language.English)),
+		)
+	}
+
+	// NOTE: the labels are not format strings, and they do not conflict with the codes.
+	type Rate struct {
+		Rate  float64 `label:"Rate %"`
+		Value string  `label:"required"`
+	}
+	rate := Rate{}
+	err = valis.Validate(&rate, valis.Field(&rate.Rate, is.GreaterThan(0)), valis.Field(&rate.Value, is.NonZero))
+	if assert.IsType(&valis.ValidationError{}, err) {
+		assert.Equal(
+			map[string][]string{
+				".Rate":  {"Rate % must be greater than 0"},
+				".Value": {"required can't be blank (or zero)"},
+			},
+			err.(*valis.ValidationError).TranslateWithLabels(message.NewPrinter(language.English, message.Catalog(c))),
+		)
+	}
+}
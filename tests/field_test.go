@@ -3,6 +3,7 @@ package tests
 import (
 	"github.com/soranoba/valis"
 	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/tagrule"
 	"github.com/soranoba/valis/when"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -121,3 +122,218 @@ func TestEachFields_commonRule(t *testing.T) {
 		"(required) .Owner.Name is required\n(required) .Users[0].Name is required",
 	)
 }
+
+type countingTagHandler struct {
+	count int
+}
+
+func (h *countingTagHandler) ParseTagValue(tagValue string) ([]valis.Rule, error) {
+	h.count++
+	return []valis.Rule{is.In(tagValue)}, nil
+}
+
+func TestEachFields_fieldTagRule(t *testing.T) {
+	assert := assert.New(t)
+	type User struct {
+		Name     string `in:"alice"`
+		Nickname string `in:"alice"`
+		Age      int
+	}
+
+	handler := &countingTagHandler{}
+	rule := valis.NewFieldTagRule("in", handler)
+	for i := 0; i < 3; i++ {
+		assert.EqualError(
+			v.Validate(&User{}, valis.EachFields(rule)),
+			"(inclusion) .Name is not included in [alice]\n(inclusion) .Nickname is not included in [alice]",
+		)
+		assert.NoError(
+			v.Validate(User{Name: "alice", Nickname: "alice"}, valis.EachFields(rule, is.Any)),
+		)
+	}
+	// NOTE: the rules created from the tag are cached.
+	assert.Equal(1, handler.count)
+
+	// NOTE: the cache is shared with the rules that have the same key and handler.
+	for i := 0; i < 3; i++ {
+		assert.NoError(
+			v.Validate(User{Name: "alice", Nickname: "alice"}, valis.EachFields(valis.NewFieldTagRule("in", handler))),
+		)
+	}
+	assert.Equal(1, handler.count)
+
+	// NOTE: the rules of another handler are not shared.
+	otherHandler := &countingTagHandler{}
+	assert.NoError(
+		v.Validate(User{Name: "alice", Nickname: "alice"}, valis.EachFields(valis.NewFieldTagRule("in", otherHandler))),
+	)
+	assert.Equal(1, otherHandler.count)
+
+	// NOTE: the handlers of the same type used alternately do not evict each other.
+	for i := 0; i < 3; i++ {
+		for _, h := range []*countingTagHandler{handler, otherHandler} {
+			assert.NoError(
+				v.Validate(User{Name: "alice", Nickname: "alice"}, valis.EachFields(valis.NewFieldTagRule("in", h))),
+			)
+		}
+	}
+	assert.Equal(1, handler.count)
+	assert.Equal(1, otherHandler.count)
+
+	// NOTE: the rules other than FieldTagRule are applied to all fields.
+	assert.EqualError(
+		v.Validate(&User{Name: "alice"}, valis.EachFields(is.NonZero, rule)),
+		"(non_zero) .Nickname can't be blank (or zero)\n"+
+			"(inclusion) .Nickname is not included in [alice]\n"+
+			"(non_zero) .Age can't be blank (or zero)",
+	)
+}
+
+func TestEachFields_embedded(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	type Audit struct {
+		UpdatedBy string `json:"updated_by"`
+	}
+	type Address struct {
+		City string `json:"city"`
+	}
+	type User struct {
+		Base
+		*Audit
+		Address Address `json:",inline"`
+		// NOTE: it shadows the Base.Name.
+		Name string `json:"name"`
+	}
+
+	v := valis.NewValidator()
+	v.SetErrorCollectorFactoryFunc(func() valis.ErrorCollector {
+		return valis.NewStandardErrorCollector(valis.JSONLocationNameResolver)
+	})
+
+	// NOTE: the embedded fields and the shadowed fields are also verified, and they are located through the embedded struct.
+	u := &User{Audit: &Audit{}}
+	assert.EqualError(
+		v.Validate(u, valis.EachFields(is.NonZero)),
+		"(non_zero) .Base can't be blank (or zero)\n"+
+			"(non_zero) .id can't be blank (or zero)\n"+
+			"(non_zero) .Base.name can't be blank (or zero)\n"+
+			"(non_zero) .updated_by can't be blank (or zero)\n"+
+			"(non_zero) .Address can't be blank (or zero)\n"+
+			"(non_zero) .city can't be blank (or zero)\n"+
+			"(non_zero) .name can't be blank (or zero)",
+	)
+
+	// NOTE: the fields of the embedded struct of nil pointer are not verified.
+	u = &User{Base: Base{ID: 1, Name: "base"}, Address: Address{City: "Tokyo"}}
+	assert.EqualError(
+		v.Validate(u, valis.EachFields(is.NonZero)),
+		"(non_zero) .Audit can't be blank (or zero)\n"+
+			"(non_zero) .name can't be blank (or zero)",
+	)
+
+	// the promoted field can be specified by Field
+	u = &User{}
+	assert.EqualError(
+		v.Validate(u, valis.Field(&u.ID, is.NonZero), valis.Field(&u.Name, is.NonZero)),
+		"(non_zero) .id can't be blank (or zero)\n"+
+			"(non_zero) .name can't be blank (or zero)",
+	)
+
+	// the embedded fields are transformed through the pointer
+	type Tagged struct {
+		Name string `normalize:"trim"`
+	}
+	type Post struct {
+		*Tagged
+	}
+	p := &Post{Tagged: &Tagged{Name: " a "}}
+	assert.NoError(v.Validate(p, valis.EachFields(valis.Transform(func(value interface{}) (interface{}, error) {
+		if _, ok := value.(string); ok {
+			return "b", nil
+		}
+		return value, nil
+	}))))
+	assert.Equal("b", p.Name)
+}
+
+func TestEachFields_embeddedCommonRule(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID *int `json:"id"`
+	}
+	type Item struct {
+		Base
+	}
+	type Order struct {
+		Base
+		Items []Item `json:"items"`
+	}
+
+	v := valis.NewValidator()
+	v.SetErrorCollectorFactoryFunc(func() valis.ErrorCollector {
+		return valis.NewStandardErrorCollector(valis.JSONPointerLocationNameResolver)
+	})
+	v.SetCommonRules(
+		when.IsStruct(valis.EachFields(is.Required)).
+			ElseWhen(when.IsSliceOrArray(valis.Each())),
+	)
+	assert.EqualError(
+		v.Validate(&Order{Items: []Item{{}}}),
+		"(required) /id is required\n"+
+			"(required) /items/0/id is required",
+	)
+}
+
+func TestEachFields_embeddedField(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: the tags of the embedded field are applied to the embedded field itself.
+	type Base struct {
+		ID string `required:"true"`
+	}
+	type A struct {
+		*Base `required:"true"`
+	}
+	assert.EqualError(
+		v.Validate(&A{}, valis.EachFields(tagrule.Required)),
+		"(required) .Base is required",
+	)
+	assert.NoError(v.Validate(&A{Base: &Base{}}, valis.EachFields(tagrule.Required)))
+
+	// NOTE: the fields of the same name at the same depth are not promoted in JSON, but they are verified.
+	type X struct {
+		ID *string `required:"true"`
+	}
+	type Y struct {
+		ID *string `required:"true"`
+	}
+	type Z struct {
+		X
+		Y
+	}
+	assert.EqualError(
+		v.Validate(&Z{}, valis.EachFields(tagrule.Required)),
+		"(required) .X.ID is required\n"+
+			"(required) .Y.ID is required",
+	)
+
+	// NOTE: the fields of the embedded struct are verified once, even if the common rules descend to the embedded struct.
+	cv := valis.NewValidator()
+	cv.SetCommonRules(when.IsStruct(valis.EachFields(tagrule.Required)))
+	assert.EqualError(
+		cv.Validate(&Z{}),
+		"(required) .X.ID is required\n"+
+			"(required) .Y.ID is required",
+	)
+	assert.EqualError(
+		valis.Validate(&Z{}, valis.Deep(tagrule.Required)),
+		"(required) .X.ID is required\n"+
+			"(required) .Y.ID is required",
+	)
+}
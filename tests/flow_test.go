@@ -18,6 +18,19 @@ func TestOr(t *testing.T) {
 		v.Validate("abc", valis.Or(is.Zero, is.In("aaa"))),
 		"(invalid) is invalid",
 	)
+
+	// NOTE: the ErrorCollector of each rule is created by the ErrorCollectorFactoryFunc.
+	count := 0
+	v := valis.NewValidator()
+	v.SetErrorCollectorFactoryFunc(func() valis.ErrorCollector {
+		count++
+		return valis.NewStandardErrorCollector(valis.DefaultLocationNameResolver)
+	})
+	assert.EqualError(
+		v.Validate("abc", valis.Or(is.Zero, is.In("aaa"))),
+		"(invalid) is invalid",
+	)
+	assert.Equal(3, count)
 }
 
 func TestWhen(t *testing.T) {
@@ -135,6 +148,38 @@ func TestWhen_Else(t *testing.T) {
 	)
 }
 
+func TestWhenContext_Parent(t *testing.T) {
+	assert := assert.New(t)
+
+	type Order struct {
+		Method string
+		Items  []string
+		Card   string
+	}
+
+	isCard := func(ctx *valis.WhenContext) bool {
+		return ctx.Parent().(*Order).Method == "card"
+	}
+	hasItems := func(ctx *valis.WhenContext) bool {
+		return len(ctx.Root().(*Order).Items) > 0
+	}
+
+	o := &Order{Method: "card", Items: []string{""}}
+	assert.EqualError(
+		v.Validate(o,
+			valis.Field(&o.Card, valis.When(isCard, is.NonZero)),
+			valis.Field(&o.Items, valis.Each(valis.When(hasItems, is.NonZero))),
+		),
+		"(non_zero) .Card can't be blank (or zero)\n"+
+			"(non_zero) .Items[0] can't be blank (or zero)",
+	)
+
+	o = &Order{Method: "cash"}
+	assert.NoError(
+		v.Validate(o, valis.Field(&o.Card, valis.When(isCard, is.NonZero))),
+	)
+}
+
 func TestEach(t *testing.T) {
 	assert := assert.New(t)
 
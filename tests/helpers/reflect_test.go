@@ -1,9 +1,11 @@
 package helpers_test
 
 import (
+	"reflect"
+	"testing"
+
 	valishelpers "github.com/soranoba/valis/helpers"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func TestIsNumeric(t *testing.T) {
@@ -16,3 +18,161 @@ func TestIsNumeric(t *testing.T) {
 	assert.False(valishelpers.IsNumeric((*int)(nil)))
 	assert.False(valishelpers.IsNumeric("1.25"))
 }
+
+func TestStructFields(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID        int `json:"id"`
+		CreatedAt int `json:"created_at"`
+	}
+	type audit struct {
+		UpdatedAt int `json:"updated_at"`
+		Name      string
+	}
+	type Meta struct {
+		Name string `json:"name"`
+		Kind string
+	}
+	type Other struct {
+		Kind string
+	}
+	type User struct {
+		Base
+		*audit
+		Meta     `json:"meta"`
+		Other    Other `json:",inline"`
+		Profile  Other
+		Name     string `json:"name"`
+		Ignored  string `json:"-"`
+		private  string
+		Embedded struct {
+			Deep int
+		}
+	}
+
+	names := make([]string, 0)
+	indexes := make([][]int, 0)
+	for _, field := range valishelpers.StructFields(reflect.TypeOf(User{})) {
+		names = append(names, valishelpers.JSONFieldName(&field))
+		indexes = append(indexes, field.Index)
+	}
+	assert.Equal([]string{"id", "created_at", "updated_at", "Name", "meta", "Kind", "Profile", "name", "Ignored", "Embedded"}, names)
+	assert.Equal([][]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2}, {3, 0}, {4}, {5}, {6}, {8}}, indexes)
+
+	// conflicts at the same depth, and the tagged field wins
+	type A struct {
+		X int
+	}
+	type B struct {
+		X int
+		Z int `json:"X"`
+	}
+	type C struct {
+		A
+		B
+	}
+	fields := valishelpers.StructFields(reflect.TypeOf(C{}))
+	if assert.Len(fields, 1) {
+		assert.Equal("Z", fields[0].Name)
+		assert.Equal([]int{1, 1}, fields[0].Index)
+	}
+
+	type D struct {
+		A
+		Other struct {
+			X int `json:"X"`
+		} `json:",inline"`
+	}
+	fields = valishelpers.StructFields(reflect.TypeOf(D{}))
+	if assert.Len(fields, 1) {
+		assert.Equal([]int{1, 0}, fields[0].Index)
+	}
+
+	// the field ignored in JSON does not hide the promoted field
+	type E struct {
+		A
+		X int `json:"-"`
+	}
+	fields = valishelpers.StructFields(reflect.TypeOf(E{}))
+	if assert.Len(fields, 2) {
+		assert.Equal([]int{0, 0}, fields[0].Index)
+		assert.Equal([]int{1}, fields[1].Index)
+	}
+
+	// recursive types
+	type Node struct {
+		*Node
+		Value int
+	}
+	fields = valishelpers.StructFields(reflect.TypeOf(Node{}))
+	if assert.Len(fields, 1) {
+		assert.Equal("Value", fields[0].Name)
+	}
+}
+
+func TestFieldByIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID int
+	}
+	type User struct {
+		*Base
+	}
+
+	val, ok := valishelpers.FieldByIndex(reflect.ValueOf(User{Base: &Base{ID: 1}}), []int{0, 0})
+	assert.True(ok)
+	assert.Equal(1, val.Interface())
+
+	_, ok = valishelpers.FieldByIndex(reflect.ValueOf(User{}), []int{0, 0})
+	assert.False(ok)
+}
+
+func TestFlattenedStructType(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID int
+	}
+	type User struct {
+		Base
+		Audit   *Base `json:",inline"`
+		Named   Base  `json:"named"`
+		Ignored *Base `json:"-"`
+		Address Base
+		Name    string
+	}
+
+	ty := reflect.TypeOf(User{})
+	for i, expected := range []bool{true, true, false, false, false, false} {
+		field := ty.Field(i)
+		flattenedTy, ok := valishelpers.FlattenedStructType(&field)
+		assert.Equal(expected, ok, field.Name)
+		if ok {
+			assert.Equal(reflect.TypeOf(Base{}), flattenedTy, field.Name)
+		}
+	}
+}
+
+func TestGetField(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID int
+	}
+	type User struct {
+		*Base
+		Name string
+	}
+
+	u := User{Base: &Base{}}
+	field := valishelpers.GetField(&u, &u.ID)
+	assert.Equal("ID", field.Name)
+	assert.Equal([]int{0, 0}, field.Index)
+
+	field = valishelpers.GetField(&u, &u.Base)
+	assert.Equal("Base", field.Name)
+
+	assert.Panics(func() { valishelpers.GetField(&u, &Base{}) })
+}
@@ -0,0 +1,139 @@
+package is_test
+
+import (
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormats(t *testing.T) {
+	assert := assert.New(t)
+
+	type Format struct {
+		Rule    valis.Rule
+		Code    string
+		Valid   []string
+		Invalid []string
+	}
+
+	formats := []*Format{
+		{
+			Rule:    is.UUID,
+			Code:    code.InvalidUUIDFormat,
+			Valid:   []string{"123e4567-e89b-12d3-a456-426614174000", "123E4567-E89B-12D3-A456-426614174000"},
+			Invalid: []string{"", "123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g"},
+		},
+		{
+			Rule:    is.IP,
+			Code:    code.InvalidIPFormat,
+			Valid:   []string{"192.0.2.1", "2001:db8::1", "::ffff:192.0.2.1"},
+			Invalid: []string{"", "192.0.2.256", "192.0.2.1/24", "example.com"},
+		},
+		{
+			Rule:    is.IPv4,
+			Code:    code.InvalidIPv4Format,
+			Valid:   []string{"192.0.2.1", "0.0.0.0"},
+			Invalid: []string{"", "192.0.2", "2001:db8::1", "::ffff:192.0.2.1"},
+		},
+		{
+			Rule:    is.IPv6,
+			Code:    code.InvalidIPv6Format,
+			Valid:   []string{"2001:db8::1", "::1", "::ffff:192.0.2.1"},
+			Invalid: []string{"", "192.0.2.1", "2001:db8:::1"},
+		},
+		{
+			Rule:    is.CIDR,
+			Code:    code.InvalidCIDRFormat,
+			Valid:   []string{"192.0.2.0/24", "2001:db8::/32"},
+			Invalid: []string{"", "192.0.2.0", "192.0.2.0/33"},
+		},
+		{
+			Rule:    is.MAC,
+			Code:    code.InvalidMACFormat,
+			Valid:   []string{"00:00:5e:00:53:01", "00-00-5E-00-53-01", "0000.5e00.5301"},
+			Invalid: []string{"", "00:00:5e:00:53", "00:00:5e:00:53:0g"},
+		},
+		{
+			Rule:    is.Hostname,
+			Code:    code.InvalidHostnameFormat,
+			Valid:   []string{"localhost", "example.com", "example.com.", "1.example.com", "a-b.example.com"},
+			Invalid: []string{"", "-a.example.com", "a-.example.com", "a..example.com", "a_b.example.com"},
+		},
+		{
+			Rule:    is.FQDN,
+			Code:    code.InvalidFQDNFormat,
+			Valid:   []string{"example.com", "example.com.", "www.example.com"},
+			Invalid: []string{"", "localhost", "192.0.2.1", ".com", "example..com"},
+		},
+		{
+			Rule:    is.Semver,
+			Code:    code.InvalidSemverFormat,
+			Valid:   []string{"0.0.0", "1.2.3", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0+20130313144700", "1.0.0-beta+exp.sha.5114f85"},
+			Invalid: []string{"", "1", "1.2", "v1.2.3", "01.2.3", "1.2.3-", "1.2.3-01"},
+		},
+		{
+			Rule:    is.Base64,
+			Code:    code.InvalidBase64Format,
+			Valid:   []string{"", "YQ==", "YWJj"},
+			Invalid: []string{"YQ", "YQ=", "!!!!"},
+		},
+		{
+			Rule:    is.HexColor,
+			Code:    code.InvalidHexColorFormat,
+			Valid:   []string{"#fff", "#FFFF", "#ffffff", "#ffffff80"},
+			Invalid: []string{"", "fff", "#ff", "#fffff", "#ggg"},
+		},
+		{
+			Rule:    is.CountryCode,
+			Code:    code.InvalidCountryCode,
+			Valid:   []string{"JP", "US", "GB"},
+			Invalid: []string{"", "jp", "JPN", "UK", "ZZ", "XA", "EU", "419"},
+		},
+		{
+			Rule:    is.CountryCodeAlpha3,
+			Code:    code.InvalidCountryCode,
+			Valid:   []string{"JPN", "USA", "GBR"},
+			Invalid: []string{"", "jpn", "JP", "XXX", "ZZZ"},
+		},
+		{
+			Rule:    is.CurrencyCode,
+			Code:    code.InvalidCurrencyCode,
+			Valid:   []string{"JPY", "USD", "EUR"},
+			Invalid: []string{"", "jpy", "JP", "ABC"},
+		},
+		{
+			Rule:    is.LanguageTag,
+			Code:    code.InvalidLanguageTag,
+			Valid:   []string{"en", "ja-JP", "zh-Hans", "pt-BR", "sr-Latn-RS"},
+			Invalid: []string{"", "en_US", "en-", "123"},
+		},
+	}
+
+	for _, format := range formats {
+		for _, s := range format.Valid {
+			assert.NoError(valis.Validate(s, format.Rule), s)
+			assert.NoError(valis.Validate(&s, format.Rule), s)
+		}
+		for _, s := range format.Invalid {
+			err := valis.Validate(s, format.Rule)
+			if assert.Error(err, s) {
+				details := err.(*valis.ValidationError).Details()
+				assert.Len(details, 1, s)
+				assert.Equal(format.Code, details[0].Code(), s)
+			}
+		}
+		assert.EqualError(
+			valis.Validate(1, format.Rule),
+			"(not_string) must be any string",
+		)
+	}
+
+	assert.EqualError(
+		valis.Validate(henge.ToStringPtr("192.0.2"), is.IP),
+		"(invalid_ip) is an invalid IP address",
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/soranoba/henge/v2"
 	"github.com/soranoba/valis"
@@ -152,6 +153,35 @@ func TestNilOrNonZero(t *testing.T) {
 	}
 }
 
+func TestDeprecated(t *testing.T) {
+	assert := assert.New(t)
+	// NOTE: IsValid is false, when it has a warning.
+	testCases := []SimpleTestCase{
+		{"", true},
+		{0, true},
+		{nil, true},
+		{(*string)(nil), true},
+		{"a", false},
+		{1, false},
+	}
+
+	for _, testCase := range testCases {
+		warnings, err := valis.ValidateWithWarnings(testCase.Value, is.Deprecated)
+		msg := fmt.Sprintf("%#v", testCase)
+		assert.NoError(err, msg)
+		if testCase.IsValid {
+			assert.NoError(warnings, msg)
+		} else {
+			if assert.Error(warnings, msg) {
+				details := warnings.(*valis.ValidationError).Details()
+				assert.Len(details, 1)
+				assert.Equal(code.Deprecated, details[0].Code())
+				assert.Equal(valis.SeverityWarning, details[0].Severity())
+			}
+		}
+	}
+}
+
 func TestAny(t *testing.T) {
 	assert := assert.New(t)
 	testCases := []SimpleTestCase{
@@ -299,7 +329,7 @@ func TestLenBetween(t *testing.T) {
 	)
 	assert.EqualError(
 		valis.Validate(0, is.LenBetween(0, 10)),
-		"(not_iterable) not_iterable",
+		"(not_iterable) must be any iterable value",
 	)
 	assert.NoError(valis.Validate("abc", is.LenBetween(0, 10)))
 }
@@ -505,3 +535,236 @@ func TestEmail(t *testing.T) {
 		"(invalid_email) is an invalid email address",
 	)
 }
+
+func TestEqualToField(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Password        string
+		PasswordConfirm string
+	}
+
+	u := User{Password: "pass", PasswordConfirm: "pass"}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.PasswordConfirm, is.EqualToField("Password"))),
+	)
+	u.PasswordConfirm = "word"
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.PasswordConfirm, is.EqualToField("Password"))),
+		"(eqfield) .PasswordConfirm must be equal to Password",
+	)
+
+	// NOTE: invalid location
+	assert.EqualError(
+		valis.Validate(u, is.EqualToField("Password")),
+		"(not_struct_field) must be any struct field",
+	)
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.PasswordConfirm, is.EqualToField("Unknown"))),
+		"(no_field) .PasswordConfirm refers to the unknown field (Unknown)",
+	)
+
+	// NOTE: the fields of the embedded struct are promoted, and they are absent when the embedded struct is nil.
+	type Base struct {
+		Password string
+	}
+	type Form struct {
+		*Base
+		Confirm string
+	}
+	f := Form{Base: &Base{Password: "pass"}, Confirm: "word"}
+	assert.EqualError(
+		valis.Validate(&f, valis.Field(&f.Confirm, is.EqualToField("Password"))),
+		"(eqfield) .Confirm must be equal to Password",
+	)
+	f.Base = nil
+	assert.NoError(
+		valis.Validate(&f, valis.Field(&f.Confirm, is.EqualToField("Password"))),
+	)
+}
+
+func TestNotEqualToField(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		OldPassword string
+		NewPassword string
+	}
+
+	u := User{OldPassword: "pass", NewPassword: "word"}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.NewPassword, is.NotEqualToField("OldPassword"))),
+	)
+	u.NewPassword = "pass"
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.NewPassword, is.NotEqualToField("OldPassword"))),
+		"(nefield) .NewPassword must not be equal to OldPassword",
+	)
+}
+
+func TestGreaterThanField(t *testing.T) {
+	assert := assert.New(t)
+
+	type Range struct {
+		Min   int
+		Max   *int64
+		Ratio float64
+		Start time.Time
+		End   time.Time
+		Name  string
+	}
+
+	now := time.Now()
+	r := Range{Min: 1, Max: henge.ToIntPtr(2), Ratio: 1.5, Start: now, End: now.Add(time.Second)}
+	assert.NoError(
+		valis.Validate(&r,
+			valis.Field(&r.Max, is.GreaterThanField("Min")),
+			valis.Field(&r.Ratio, is.GreaterThanField("Min")),
+			valis.Field(&r.End, is.GreaterThanField("Start")),
+		),
+	)
+
+	r = Range{Min: 2, Max: henge.ToIntPtr(2), Ratio: 2, Start: now, End: now}
+	assert.EqualError(
+		valis.Validate(&r,
+			valis.Field(&r.Max, is.GreaterThanField("Min")),
+			valis.Field(&r.Ratio, is.GreaterThanField("Min")),
+			valis.Field(&r.End, is.GreaterThanField("Start")),
+		),
+		"(gtfield) .Max must be greater than Min\n"+
+			"(gtfield) .Ratio must be greater than Min\n"+
+			"(gtfield) .End must be greater than Start",
+	)
+
+	// NOTE: nil is not verified
+	r.Max = nil
+	assert.NoError(
+		valis.Validate(&r, valis.Field(&r.Max, is.GreaterThanField("Min"))),
+	)
+
+	// NOTE: not comparable
+	assert.EqualError(
+		valis.Validate(&r, valis.Field(&r.Name, is.GreaterThanField("Min"))),
+		"(invalid) .Name is invalid",
+	)
+}
+
+func TestLessThanField(t *testing.T) {
+	assert := assert.New(t)
+
+	type Range struct {
+		From string
+		To   string
+	}
+
+	r := Range{From: "a", To: "b"}
+	assert.NoError(
+		valis.Validate(&r, valis.Field(&r.From, is.LessThanField("To"))),
+	)
+	r.From = "b"
+	assert.EqualError(
+		valis.Validate(&r, valis.Field(&r.From, is.LessThanField("To"))),
+		"(ltfield) .From must be less than To",
+	)
+}
+
+func TestRequiredIf(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Role  string
+		Level *int64
+		Team  string
+	}
+
+	u := User{Role: "admin"}
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.Team, is.RequiredIf("Role", "admin", "owner"))),
+		"(required_if) .Team is required when Role is admin",
+	)
+	u.Team = "a"
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Team, is.RequiredIf("Role", "admin", "owner"))),
+	)
+	u = User{Role: "member"}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Team, is.RequiredIf("Role", "admin", "owner"))),
+	)
+
+	// NOTE: the values are compared after converting to strings.
+	u = User{Level: henge.ToIntPtr(1)}
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.Team, is.RequiredIf("Level", 1))),
+		"(required_if) .Team is required when Level is 1",
+	)
+	u = User{}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Team, is.RequiredIf("Level", 1))),
+	)
+}
+
+func TestExcludedIf(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Role string
+		Team string
+	}
+
+	u := User{Role: "guest", Team: "a"}
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.Team, is.ExcludedIf("Role", "guest"))),
+		"(excluded_if) .Team must be blank when Role is guest",
+	)
+	u.Team = ""
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Team, is.ExcludedIf("Role", "guest"))),
+	)
+	u = User{Role: "admin", Team: "a"}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Team, is.ExcludedIf("Role", "guest"))),
+	)
+}
+
+func TestRequiredWith(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Email  string
+		Phone  *string
+		Notify string
+	}
+
+	u := User{}
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Notify, is.RequiredWith("Email", "Phone"))),
+	)
+	u.Phone = henge.ToStringPtr("")
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.Notify, is.RequiredWith("Email", "Phone"))),
+		"(required_with) .Notify is required when Phone is present",
+	)
+	u.Notify = "email"
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Notify, is.RequiredWith("Email", "Phone"))),
+	)
+}
+
+func TestRequiredWithout(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Email string
+		Phone string
+	}
+
+	u := User{}
+	assert.EqualError(
+		valis.Validate(&u, valis.Field(&u.Phone, is.RequiredWithout("Email"))),
+		"(required_without) .Phone is required when Email is not present",
+	)
+	u.Email = "a@example.com"
+	assert.NoError(
+		valis.Validate(&u, valis.Field(&u.Phone, is.RequiredWithout("Email"))),
+	)
+}
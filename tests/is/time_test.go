@@ -0,0 +1,103 @@
+package is_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBefore(t *testing.T) {
+	assert := assert.New(t)
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(valis.Validate(day.Add(-time.Second), is.Before(day)))
+	assert.EqualError(
+		valis.Validate(day, is.Before(day)),
+		"(before) must be before 2021-01-01 00:00:00 +0000 UTC",
+	)
+	assert.EqualError(
+		valis.Validate("2020-01-01", is.Before(day)),
+		"(not_time) must be any time",
+	)
+}
+
+func TestAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	next := day.Add(time.Second)
+	assert.NoError(valis.Validate(&next, is.After(day)))
+	assert.EqualError(
+		valis.Validate(day, is.After(day)),
+		"(after) must be after 2021-01-01 00:00:00 +0000 UTC",
+	)
+	assert.EqualError(
+		valis.Validate((*time.Time)(nil), is.After(day)),
+		"(not_time) must be any time",
+	)
+}
+
+func TestTimeBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	min := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+	assert.NoError(valis.Validate(min, is.TimeBetween(min, max)))
+	assert.NoError(valis.Validate(max, is.TimeBetween(min, max)))
+	for _, v := range []time.Time{min.Add(-time.Nanosecond), max.Add(time.Nanosecond)} {
+		assert.EqualError(
+			valis.Validate(v, is.TimeBetween(min, max)),
+			"(time_out_of_range) must be between 2021-01-01 00:00:00 +0000 UTC and 2021-12-31 00:00:00 +0000 UTC",
+		)
+	}
+}
+
+func TestInFuture(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate(time.Now().Add(time.Hour), is.InFuture))
+	assert.EqualError(
+		valis.Validate(time.Now().Add(-time.Hour), is.InFuture),
+		"(future) must be in the future",
+	)
+}
+
+func TestInPast(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate(time.Now().Add(-time.Hour), is.InPast))
+	assert.EqualError(
+		valis.Validate(time.Now().Add(time.Hour), is.InPast),
+		"(past) must be in the past",
+	)
+}
+
+func TestWeekday(t *testing.T) {
+	assert := assert.New(t)
+
+	friday := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(valis.Validate(friday, is.Weekday(time.Friday, time.Saturday)))
+	assert.EqualError(
+		valis.Validate(friday, is.Weekday(time.Saturday, time.Sunday)),
+		"(weekday) must be one of the weekdays [Saturday Sunday]",
+	)
+}
+
+func TestDurationBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate(time.Second, is.DurationBetween(time.Second, time.Minute)))
+	d := time.Minute
+	assert.NoError(valis.Validate(&d, is.DurationBetween(time.Second, time.Minute)))
+	assert.EqualError(
+		valis.Validate(time.Hour, is.DurationBetween(time.Second, time.Minute)),
+		"(duration_out_of_range) must be between 1s and 1m0s",
+	)
+	assert.EqualError(
+		valis.Validate(int64(1), is.DurationBetween(time.Second, time.Minute)),
+		"(not_duration) must be any duration",
+	)
+}
@@ -0,0 +1,158 @@
+package is_test
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestASCII(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate("", is.ASCII))
+	assert.NoError(valis.Validate("abc 123 !~\t", is.ASCII))
+	assert.EqualError(
+		valis.Validate("café", is.ASCII),
+		"(ascii_only) must contain only ASCII characters",
+	)
+	assert.EqualError(
+		valis.Validate(1, is.ASCII),
+		"(not_string) must be any string",
+	)
+}
+
+func TestPrintable(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate("abc 123 あいう 🇯🇵", is.Printable))
+	assert.EqualError(
+		valis.Validate("abc\t", is.Printable),
+		"(printable_only) must contain only printable characters",
+	)
+	assert.EqualError(
+		valis.Validate("\u200b", is.Printable),
+		"(printable_only) must contain only printable characters",
+	)
+}
+
+func TestAlphanumeric(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate("abc123", is.Alphanumeric))
+	assert.NoError(valis.Validate("あいう１２３", is.Alphanumeric))
+	assert.EqualError(
+		valis.Validate("abc 123", is.Alphanumeric),
+		"(alphanumeric_only) must contain only letters and digits",
+	)
+	assert.EqualError(
+		valis.Validate("abc-123", is.Alphanumeric),
+		"(alphanumeric_only) must contain only letters and digits",
+	)
+}
+
+func TestNoControlCharacters(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(valis.Validate("abc あいう\u200b", is.NoControlCharacters))
+	for _, s := range []string{"\x00", "a\nb", "\x7f", "\u0085"} {
+		assert.EqualError(
+			valis.Validate(s, is.NoControlCharacters),
+			"(no_control_characters) must not contain control characters",
+			s,
+		)
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	assert := assert.New(t)
+
+	nfc, nfd := "caf\u00e9", "cafe\u0301"
+	assert.NoError(valis.Validate(nfc, is.NFC))
+	assert.EqualError(
+		valis.Validate(nfd, is.NFC),
+		"(not_normalized) must be normalized in NFC",
+	)
+	assert.NoError(valis.Validate(&nfd, is.Normalized(norm.NFD)))
+	assert.EqualError(
+		valis.Validate("\uff71", is.Normalized(norm.NFKC)),
+		"(not_normalized) must be normalized in NFKC",
+	)
+}
+
+func TestGraphemeLengthBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	lengths := map[string]int{
+		"":           0,
+		"abc":        3,
+		"あいう":        3,
+		"cafe\u0301": 4, // combining character
+		"\r\n":       1,
+		"🇯🇵":         1, // flag
+		"🇯🇵🇺🇸🇬":      3, // flags and a regional indicator
+		"👍🏽":         1, // emoji modifier
+		"👨‍👩‍👧‍👦":    1, // ZWJ sequence
+		"❤️":         1, // variation selector
+		"\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F": 1, // tag sequence
+		"각":                      1, // Hangul jamo
+		"한국어":                      3,
+		"\u0915\u094d\u0937\u093f": 1, // Indic conjunct
+		"\u0600\u0661":             1, // Prepend
+		"\u0915\u093f\u0915":       2, // NOTE: the conjunct requires the linker
+		"\u0995\u09cd\u09b7":       1, // Bengali conjunct
+		"a\u200db":                 2,
+	}
+	for s, length := range lengths {
+		assert.NoError(valis.Validate(s, is.GraphemeLengthBetween(length, length)), s)
+		assert.Error(valis.Validate(s, is.GraphemeLengthBetween(length+1, length+1)), s)
+	}
+
+	assert.EqualError(
+		valis.Validate("🇯🇵🇺🇸", is.GraphemeLengthBetween(3, 5)),
+		"(too_short_grapheme_length) is too short length (minimum is 3 characters)",
+	)
+	assert.EqualError(
+		valis.Validate("🇯🇵🇺🇸", is.GraphemeLengthBetween(0, 1)),
+		"(too_long_grapheme_length) is too long length (maximum is 1 character)",
+	)
+}
+
+func TestGraphemeLengthBetween_conformance(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := os.ReadFile("testdata/GraphemeBreakTest.txt")
+	if !assert.NoError(err) {
+		return
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var sb strings.Builder
+		length := -1
+		for _, field := range strings.Fields(line) {
+			switch field {
+			case "÷":
+				length++
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if !assert.NoError(err, line) {
+					return
+				}
+				sb.WriteRune(rune(r))
+			}
+		}
+		assert.NoError(valis.Validate(sb.String(), is.GraphemeLengthBetween(length, length)), line)
+	}
+}
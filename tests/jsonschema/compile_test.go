@@ -0,0 +1,200 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/jsonschema"
+	"github.com/soranoba/valis/tagrule"
+	"github.com/stretchr/testify/assert"
+)
+
+func compile(t *testing.T, s string) valis.Rule {
+	var schema jsonschema.Schema
+	if err := json.Unmarshal([]byte(s), &schema); err != nil {
+		t.Fatal(err)
+	}
+	rule, err := jsonschema.Compile(&schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rule
+}
+
+func decode(t *testing.T, s string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestCompile(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := compile(t, `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "exclusiveMinimum": 0},
+			"name": {"type": "string", "pattern": "^[a-z]+$", "maxLength": 5},
+			"email": {"type": "string", "format": "email"},
+			"role": {"enum": ["admin", "member"]},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+			"nickname": {"type": ["string", "null"]},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+		},
+		"required": ["id", "name"],
+		"additionalProperties": false
+	}`)
+
+	assert.NoError(valis.Validate(decode(t, `{
+		"id": 1, "name": "alice", "email": "alice@example.com", "role": "admin",
+		"tags": ["a", "b"], "nickname": null, "labels": {"a": "b"}
+	}`), rule))
+
+	assert.EqualError(
+		valis.Validate(decode(t, `{
+			"id": 0, "name": "Alice!", "email": "alice", "role": "owner",
+			"tags": ["a", 1, "c"], "nickname": 1, "labels": {"a": true}, "extra": 1
+		}`), rule),
+		"(invalid_email) [key: email] is an invalid email address\n"+
+			"(gt) [key: id] must be greater than 0\n"+
+			"(not_string) [key: labels][a] must be any string\n"+
+			"(too_long_length) [key: name] is too long length (maximum is 5 characters)\n"+
+			"(regexp) [key: name] is a mismatch with the regular expression. (^[a-z]+$)\n"+
+			"(invalid) [key: nickname] is invalid\n"+
+			"(inclusion) [key: role] is not included in [admin member]\n"+
+			"(too_long_len) [key: tags] is too many elements (maximum is 2 elements)\n"+
+			"(not_string) [key: tags][1] must be any string\n"+
+			"(invalid) [extra] is invalid",
+	)
+
+	assert.EqualError(
+		valis.Validate(decode(t, `{}`), rule),
+		"(no_key) requires the value at the key (id)\n"+
+			"(no_key) requires the value at the key (name)",
+	)
+	assert.EqualError(
+		valis.Validate(decode(t, `[]`), rule),
+		"(not_map) must be any map",
+	)
+}
+
+func TestCompile_composition(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := compile(t, `{
+		"allOf": [{"type": "number"}, {"minimum": 1}],
+		"anyOf": [{"maximum": 10}, {"const": 100}],
+		"not": {"const": 5}
+	}`)
+	assert.NoError(valis.Validate(decode(t, `1`), rule))
+	assert.NoError(valis.Validate(decode(t, `100`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `5`), rule),
+		"(invalid) is invalid",
+	)
+	assert.Error(valis.Validate(decode(t, `"a"`), rule))
+	assert.Error(valis.Validate(decode(t, `50`), rule))
+}
+
+func TestCompile_ref(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := compile(t, `{
+		"$ref": "#/$defs/Node",
+		"$defs": {
+			"Node": {
+				"type": "object",
+				"properties": {
+					"value": {"type": "integer"},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Node"}}
+				},
+				"required": ["value"]
+			}
+		}
+	}`)
+	assert.NoError(valis.Validate(decode(t, `{"value": 1, "children": [{"value": 2}]}`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `{"value": 1, "children": [{"value": 2, "children": [{}]}]}`), rule),
+		"(no_key) [key: children][0][key: children][0] requires the value at the key (value)",
+	)
+
+	var schema jsonschema.Schema
+	assert.NoError(json.Unmarshal([]byte(`{"$ref": "#/$defs/Unknown"}`), &schema))
+	_, err := jsonschema.Compile(&schema)
+	assert.EqualError(err, "unresolved $ref (#/$defs/Unknown)")
+
+	// NOTE: the tokens are unescaped.
+	rule = compile(t, `{
+		"type": "object",
+		"properties": {
+			"a": {"$ref": "#/$defs/a~1b"},
+			"b": {"$ref": "#/$defs/c~0d"},
+			"c": {"$ref": "#/$defs/e%20f"}
+		},
+		"$defs": {
+			"a/b": {"type": "integer"},
+			"c~d": {"type": "string"},
+			"e f": {"type": "boolean"}
+		}
+	}`)
+	assert.NoError(valis.Validate(decode(t, `{"a": 1, "b": "x", "c": true}`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `{"a": "x", "b": 1, "c": 1}`), rule),
+		"(not_integer) [key: a] must be any integer\n(not_string) [key: b] must be any string\n(not_boolean) [key: c] must be any boolean",
+	)
+}
+
+func TestCompile_null(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: null is included in the enum.
+	rule := compile(t, `{"enum": ["a", null]}`)
+	assert.NoError(valis.Validate(decode(t, `"a"`), rule))
+	assert.NoError(valis.Validate(decode(t, `null`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `"b"`), rule),
+		"(inclusion) is not included in [a <nil>]",
+	)
+	rule = compile(t, `{"enum": ["a"]}`)
+	assert.EqualError(
+		valis.Validate(decode(t, `null`), rule),
+		"(inclusion) is not included in [a]",
+	)
+
+	// NOTE: const can be null.
+	rule = compile(t, `{"const": null}`)
+	assert.NoError(valis.Validate(decode(t, `null`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `"a"`), rule),
+		"(inclusion) is not included in [<nil>]",
+	)
+
+	// NOTE: the null const is kept through JSON.
+	var decoded jsonschema.Schema
+	assert.NoError(json.Unmarshal([]byte(`{"const": null}`), &decoded))
+	b, err := json.Marshal(&decoded)
+	assert.NoError(err)
+	assert.JSONEq(`{"const": null}`, string(b))
+	b, err = json.Marshal(&jsonschema.Schema{})
+	assert.NoError(err)
+	assert.JSONEq(`{}`, string(b))
+
+	// NOTE: the schema generated from the optional pointer field accepts null.
+	type User struct {
+		Role *string `json:"role" enums:"admin,member"`
+	}
+	schema, err := jsonschema.Generate(reflect.TypeOf(User{}), tagrule.Enums)
+	assert.NoError(err)
+	rule, err = jsonschema.Compile(schema)
+	assert.NoError(err)
+	assert.NoError(valis.Validate(decode(t, `{"role": null}`), rule))
+	assert.NoError(valis.Validate(decode(t, `{"role": "admin"}`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `{"role": "owner"}`), rule),
+		"(inclusion) [key: role] is not included in [admin member <nil>]",
+	)
+}
@@ -0,0 +1,210 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/jsonschema"
+	"github.com/soranoba/valis/tagrule"
+	"github.com/stretchr/testify/assert"
+)
+
+type Node struct {
+	Value    int     `json:"value" validate:"nonzero"`
+	Children []*Node `json:"children" validate:"max=2"`
+}
+
+func TestGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	type Item struct {
+		Name string `json:"name" validate:"required,len=3"`
+	}
+	type Order struct {
+		ID        uint              `json:"id" validate:"lt=100"`
+		Price     float64           `json:"price" validate:"gt=0,lte=1000"`
+		Count     *int              `json:"count" validate:"oneof=1 2 3"`
+		Items     []Item            `json:"items" validate:"min=1,max=10"`
+		Item      *Item             `json:"item"`
+		Labels    map[string]string `json:"labels" validate:"max=5"`
+		Size      [2]float32        `json:"size"`
+		URL       string            `json:"url" validate:"url"`
+		Data      []byte            `json:"data"`
+		CreatedAt time.Time         `json:"created_at"`
+		Extra     interface{}       `json:"extra"`
+		Ignored   string            `json:"-"`
+		Done      bool
+		private   string
+	}
+
+	schema, err := jsonschema.Generate(reflect.TypeOf(&Order{}), tagrule.Required, tagrule.Validate)
+	if !assert.NoError(err) {
+		return
+	}
+	b, _ := json.Marshal(schema)
+	assert.JSONEq(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 0, "exclusiveMaximum": 100},
+			"price": {"type": "number", "exclusiveMinimum": 0, "maximum": 1000},
+			"count": {"type": ["integer", "null"], "enum": [1, 2, 3, null]},
+			"items": {"type": "array", "items": {"$ref": "#/$defs/Item"}, "minItems": 1, "maxItems": 10},
+			"item": {"anyOf": [{"$ref": "#/$defs/Item"}, {"type": "null"}]},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}, "maxProperties": 5},
+			"size": {"type": "array", "items": {"type": "number"}, "minItems": 2, "maxItems": 2},
+			"url": {"type": "string", "format": "uri"},
+			"data": {"type": "string"},
+			"created_at": {"type": "string", "format": "date-time"},
+			"extra": {},
+			"Done": {"type": "boolean"}
+		},
+		"$defs": {
+			"Item": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "minLength": 3, "maxLength": 3}
+				},
+				"required": ["name"]
+			}
+		}
+	}`, string(b))
+
+	// NOTE: the recursive types refer to the definition.
+	schema, err = jsonschema.Generate(reflect.TypeOf([]Node{}), tagrule.Validate)
+	if !assert.NoError(err) {
+		return
+	}
+	b, _ = json.Marshal(schema)
+	assert.JSONEq(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "array",
+		"items": {"$ref": "#/$defs/Node"},
+		"$defs": {
+			"Node": {
+				"type": "object",
+				"properties": {
+					"value": {"type": "integer", "not": {"const": 0}},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Node"}, "maxItems": 2}
+				},
+				"required": ["value"]
+			}
+		}
+	}`, string(b))
+
+	schema, err = jsonschema.Generate(reflect.TypeOf(Node{}))
+	if assert.NoError(err) {
+		assert.Equal(&jsonschema.Schema{Ref: "#"}, schema.Properties["children"].Items)
+	}
+
+	// NOTE: it returns an error when the type is not supported or the tag is invalid.
+	_, err = jsonschema.Generate(reflect.TypeOf(struct{ F func() }{}))
+	assert.Error(err)
+	_, err = jsonschema.Generate(reflect.TypeOf(struct {
+		F string `validate:"min=a"`
+	}{}), tagrule.Validate)
+	assert.Error(err)
+}
+
+func TestGenerate_formats(t *testing.T) {
+	assert := assert.New(t)
+
+	type Host struct {
+		FQDN     string  `json:"fqdn" validate:"fqdn"`
+		IP       *string `json:"ip" validate:"ip"`
+		Version  string  `json:"version" validate:"semver"`
+		Color    string  `json:"color" validate:"hexcolor"`
+		CIDR     string  `json:"cidr" validate:"cidr"`
+		MAC      string  `json:"mac" validate:"mac"`
+		Data     string  `json:"data" validate:"base64"`
+		Country  string  `json:"country" validate:"iso3166_1_alpha2"`
+		Country3 string  `json:"country3" validate:"iso3166_1_alpha3"`
+		Currency string  `json:"currency" validate:"iso4217"`
+		Language string  `json:"language" validate:"bcp47_language_tag"`
+	}
+
+	schema, err := jsonschema.Generate(reflect.TypeOf(&Host{}), tagrule.Validate)
+	if !assert.NoError(err) {
+		return
+	}
+	b, _ := json.Marshal(schema.Properties["fqdn"])
+	assert.JSONEq(`{"type": "string", "format": "hostname"}`, string(b))
+	b, _ = json.Marshal(schema.Properties["ip"])
+	assert.JSONEq(`{"type": ["string", "null"], "anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]}`, string(b))
+
+	// NOTE: they can't be expressed exactly, so the schemas don't restrict them.
+	for _, name := range []string{"cidr", "mac", "data", "country", "country3", "currency", "language"} {
+		b, _ = json.Marshal(schema.Properties[name])
+		assert.JSONEq(`{"type": "string"}`, string(b), name)
+	}
+
+	rule, err := jsonschema.Compile(schema)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.NoError(valis.Validate(decode(t, `{
+		"fqdn": "example.com", "ip": "::1", "version": "1.0.0-alpha+001", "color": "#ffffff80",
+		"cidr": "", "mac": "", "data": "", "country": "", "country3": "", "currency": "", "language": ""
+	}`), rule))
+	assert.EqualError(
+		valis.Validate(decode(t, `{
+			"fqdn": "-", "ip": "a", "version": "01.0.0", "color": "fff",
+			"cidr": "", "mac": "", "data": "", "country": "", "country3": "", "currency": "", "language": ""
+		}`), rule),
+		"(regexp) [key: color] is a mismatch with the regular expression. (^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$)\n"+
+			"(invalid_hostname) [key: fqdn] is an invalid hostname\n"+
+			"(invalid) [key: ip] is invalid\n"+
+			"(regexp) [key: version] is a mismatch with the regular expression. (^(0|[1-9]\\d*)\\.(0|[1-9]\\d*)\\.(0|[1-9]\\d*)(?:-((?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\\.(?:0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\\+([0-9a-zA-Z-]+(?:\\.[0-9a-zA-Z-]+)*))?$)",
+	)
+}
+
+func TestTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	var types jsonschema.Types
+	assert.NoError(json.Unmarshal([]byte(`"string"`), &types))
+	assert.Equal(jsonschema.Types{"string"}, types)
+	assert.NoError(json.Unmarshal([]byte(`["string", "null"]`), &types))
+	assert.Equal(jsonschema.Types{"string", "null"}, types)
+	assert.Error(json.Unmarshal([]byte(`1`), &types))
+
+	b, _ := json.Marshal(jsonschema.Types{"string"})
+	assert.Equal(`"string"`, string(b))
+	b, _ = json.Marshal(jsonschema.Types{"string", "null"})
+	assert.Equal(`["string","null"]`, string(b))
+}
+
+func TestGenerate_embedded(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID int `json:"id" validate:"required"`
+	}
+	type Address struct {
+		City string `json:"city"`
+	}
+	type User struct {
+		Base
+		Address Address `json:",inline"`
+		Name    string  `json:"name"`
+	}
+
+	schema, err := jsonschema.Generate(reflect.TypeOf(User{}), tagrule.Validate)
+	if !assert.NoError(err) {
+		return
+	}
+	b, _ := json.Marshal(schema)
+	assert.JSONEq(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"city": {"type": "string"},
+			"name": {"type": "string"}
+		},
+		"required": ["id"]
+	}`, string(b))
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPointerLocationNameResolver(t *testing.T) {
+	assert := assert.New(t)
+
+	type Item struct {
+		Name string `json:"name"`
+	}
+	type Order struct {
+		Items  []Item            `json:"items"`
+		Labels map[string]string `json:"a/b~c"`
+		Note   string            `json:",omitempty"`
+	}
+
+	v := valis.NewValidator()
+	v.SetErrorCollectorFactoryFunc(func() valis.ErrorCollector {
+		return valis.NewStandardErrorCollector(valis.JSONPointerLocationNameResolver)
+	})
+
+	o := &Order{Items: []Item{{}}, Labels: map[string]string{"x/y": ""}}
+	assert.EqualError(
+		v.Validate(o,
+			valis.Field(&o.Items, valis.Each(valis.EachFields(is.NonZero))),
+			valis.Field(&o.Labels, valis.EachValues(is.NonZero), valis.EachKeys(is.In("z"))),
+			valis.Field(&o.Note, is.NonZero),
+		),
+		"(non_zero) /items/0/name can't be blank (or zero)\n"+
+			"(non_zero) /a~1b~0c/x~1y can't be blank (or zero)\n"+
+			"(inclusion) /a~1b~0c/x~1y is not included in [z]\n"+
+			"(non_zero) /Note can't be blank (or zero)",
+	)
+}
+
+func TestParseJSONPointer(t *testing.T) {
+	assert := assert.New(t)
+
+	type Item struct {
+		Name   string `json:"name"`
+		Secret string `json:"-"`
+	}
+	type Order struct {
+		Items  []*Item                 `json:"items"`
+		Sizes  [2]int                  `json:"sizes"`
+		Labels map[string]string       `json:"a/b~c"`
+		Counts map[int]int             `json:"counts"`
+		Extra  map[string]interface{}  `json:"extra"`
+		Nested map[string]map[int]Item `json:"nested"`
+	}
+
+	ty := reflect.TypeOf(&Order{})
+	for _, pointer := range []string{
+		"",
+		"/items",
+		"/items/0/name",
+		"/sizes/1",
+		"/a~1b~0c/x~1y",
+		"/counts/10",
+		"/extra/a/0/b",
+		"/nested/a/1/name",
+	} {
+		loc, err := valis.ParseJSONPointer(pointer, ty)
+		if assert.NoError(err, pointer) {
+			assert.Equal(pointer, valis.JSONPointerLocationNameResolver.ResolveLocationName(loc))
+		}
+	}
+
+	loc, err := valis.ParseJSONPointer("/items/12/name", ty)
+	if assert.NoError(err) {
+		assert.Equal(valis.LocationKindField, loc.Kind())
+		assert.Equal("Name", loc.Field().Name)
+		assert.Equal(valis.LocationKindIndex, loc.Parent().Kind())
+		assert.Equal(12, loc.Parent().Index())
+	}
+	loc, err = valis.ParseJSONPointer("/counts/10", ty)
+	if assert.NoError(err) {
+		assert.Equal(valis.LocationKindMapValue, loc.Kind())
+		assert.Equal(10, loc.Key())
+	}
+
+	for _, pointer := range []string{
+		"items",
+		"/unknown",
+		"/items/-",
+		"/items/01",
+		"/items/0/Secret",
+		"/sizes/2",
+		"/counts/a",
+		"/items/0/name/a",
+	} {
+		_, err := valis.ParseJSONPointer(pointer, ty)
+		assert.Error(err, pointer)
+	}
+}
+
+func TestLocation_Label(t *testing.T) {
+	assert := assert.New(t)
+
+	type Item struct {
+		Name string
+	}
+	type Order struct {
+		Items []Item            `label:"Items"`
+		Tags  map[string]string `label:"Tags"`
+		Note  string
+	}
+
+	ty := reflect.TypeOf(Order{})
+	itemsField, _ := ty.FieldByName("Items")
+	tagsField, _ := ty.FieldByName("Tags")
+	noteField, _ := ty.FieldByName("Note")
+	nameField, _ := reflect.TypeOf(Item{}).FieldByName("Name")
+
+	root := valis.NewValidator().Location()
+	label, ok := root.Label()
+	assert.False(ok)
+	assert.Equal("", label)
+
+	label, ok = root.FieldLocation(&itemsField).Label()
+	assert.True(ok)
+	assert.Equal("Items", label)
+
+	label, ok = root.FieldLocation(&itemsField).IndexLocation(0).Label()
+	assert.True(ok)
+	assert.Equal("Items", label)
+
+	label, ok = root.FieldLocation(&tagsField).MapKeyLocation("a").Label()
+	assert.True(ok)
+	assert.Equal("Tags", label)
+
+	label, ok = root.FieldLocation(&tagsField).MapValueLocation("a").Label()
+	assert.True(ok)
+	assert.Equal("Tags", label)
+
+	_, ok = root.FieldLocation(&noteField).Label()
+	assert.False(ok)
+
+	// NOTE: the label of the parent field is not used for the fields of the element.
+	_, ok = root.FieldLocation(&itemsField).IndexLocation(0).FieldLocation(&nameField).Label()
+	assert.False(ok)
+}
+
+func TestParseJSONPointer_embedded(t *testing.T) {
+	assert := assert.New(t)
+
+	type Base struct {
+		ID int `json:"id"`
+	}
+	type Item struct {
+		*Base
+		Name string `json:"name"`
+	}
+	type Order struct {
+		Base
+		Items []Item `json:"items"`
+	}
+
+	ty := reflect.TypeOf(&Order{})
+	for _, pointer := range []string{"/id", "/items/0/id", "/items/0/name"} {
+		loc, err := valis.ParseJSONPointer(pointer, ty)
+		if assert.NoError(err, pointer) {
+			assert.Equal(pointer, valis.JSONPointerLocationNameResolver.ResolveLocationName(loc))
+		}
+	}
+
+	loc, err := valis.ParseJSONPointer("/items/0/id", ty)
+	if assert.NoError(err) {
+		assert.Equal("ID", loc.Field().Name)
+		assert.Equal([]int{0, 0}, loc.Field().Index)
+	}
+
+	_, err = valis.ParseJSONPointer("/Base", ty)
+	assert.Error(err)
+
+	// the field ignored in JSON does not hide the promoted field
+	type Profile struct {
+		Name string
+	}
+	type User struct {
+		Profile
+		Name string `json:"-"`
+	}
+	loc, err = valis.ParseJSONPointer("/Name", reflect.TypeOf(&User{}))
+	if assert.NoError(err) {
+		assert.Equal([]int{0, 0}, loc.Field().Index)
+	}
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/translations"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestWithCode(t *testing.T) {
+	assert := assert.New(t)
+
+	usernameLength := valis.WithCode("username_length", 3, 20)
+
+	assert.NoError(v.Validate("taro", usernameLength(is.LengthBetween(3, 20))))
+	// NOTE: the translation of the code is not registered.
+	assert.EqualError(
+		v.Validate("a", usernameLength(is.LengthBetween(3, 20))),
+		"(username_length) username_length",
+	)
+	// NOTE: the errors at the descendant locations are also replaced.
+	assert.EqualError(
+		v.Validate([]string{"taro", "a"}, valis.WithCode(code.Invalid)(valis.Each(is.LengthBetween(3, 20)))),
+		"(invalid) [1] is invalid",
+	)
+
+	c := translations.NewCatalog()
+	c.Set(translations.DefaultEnglish)
+	c.Set(func(b *catalog.Builder) {
+		b.Set(language.English, "username_length", catalog.String("must be %[1]d-%[2]d characters"))
+	})
+	err := v.Validate("a", usernameLength(is.LengthBetween(3, 20)))
+	if assert.IsType(&valis.ValidationError{}, err) {
+		assert.Equal(
+			map[string][]string{"": {"must be 3-20 characters"}},
+			err.(*valis.ValidationError).Translate(message.NewPrinter(language.English, message.Catalog(c))),
+		)
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Username string
+	}
+	user := User{Username: "a"}
+
+	err := v.Validate(&user, valis.Field(&user.Username,
+		valis.WithMessage("must be %[1]d-%[2]d characters", 3, 20)(is.LengthBetween(3, 20), is.Alphanumeric),
+	))
+	// NOTE: the code is not replaced.
+	assert.EqualError(err, "(too_short_length) .Username must be 3-20 characters")
+	if assert.IsType(&valis.ValidationError{}, err) {
+		details := err.(*valis.ValidationError).Details()
+		assert.Equal(code.TooShortLength, details[0].Code())
+		assert.Equal("must be %[1]d-%[2]d characters", details[0].MessageKey())
+		assert.Equal([]interface{}{3, 20}, details[0].Params())
+	}
+
+	// NOTE: the message is translated, when the catalog has the translation of the message.
+	c := translations.NewCatalog()
+	c.Set(func(b *catalog.Builder) {
+		b.Set(language.Japanese, "must be %[1]d-%[2]d characters", catalog.String("は%[1]d〜%[2]d文字である必要があります"))
+	})
+	assert.Equal(
+		map[string][]string{".Username": {"は3〜20文字である必要があります"}},
+		err.(*valis.ValidationError).Translate(message.NewPrinter(language.Japanese, message.Catalog(c))),
+	)
+
+	// NOTE: the outer rule has priority.
+	assert.EqualError(
+		v.Validate("a", valis.WithCode("custom_code")(valis.WithMessage("custom message")(is.LengthBetween(3, 20)))),
+		"(custom_code) custom_code",
+	)
+	assert.EqualError(
+		v.Validate("a", valis.WithMessage("custom message")(valis.WithCode("custom_code")(is.LengthBetween(3, 20)))),
+		"(custom_code) custom message",
+	)
+}
+
+func TestWithLiteralMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: the message is not a format, so it is used as it is.
+	err := v.Validate("あ", valis.WithLiteralMessage("must be 100% ASCII")(is.ASCII))
+	assert.EqualError(err, "(ascii_only) must be 100% ASCII")
+
+	// NOTE: the message is translated, when the catalog has the translation of the message.
+	// The translation is a format, unlike the message.
+	c := translations.NewCatalog()
+	c.Set(func(b *catalog.Builder) {
+		b.Set(language.Japanese, "must be 100% ASCII", catalog.String("は100%%ASCIIである必要があります"))
+	})
+	assert.Equal(
+		map[string][]string{"": {"は100%ASCIIである必要があります"}},
+		err.(*valis.ValidationError).Translate(message.NewPrinter(language.Japanese, message.Catalog(c))),
+	)
+	assert.Equal(
+		map[string][]string{"": {"must be 100% ASCII"}},
+		err.(*valis.ValidationError).Translate(message.NewPrinter(language.English, message.Catalog(c))),
+	)
+}
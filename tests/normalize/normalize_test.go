@@ -0,0 +1,87 @@
+package normalize_test
+
+import (
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/normalize"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	v = valis.NewValidator()
+)
+
+func TestString(t *testing.T) {
+	assert := assert.New(t)
+
+	s := " Foo@Example.COM "
+	assert.NoError(v.Validate(&s, normalize.Trim, normalize.Lower, is.In("foo@example.com")))
+	assert.Equal("foo@example.com", s)
+
+	assert.NoError(v.Validate(&s, normalize.Upper))
+	assert.Equal("FOO@EXAMPLE.COM", s)
+
+	// NOTE: "e" + U+0301 (combining acute accent) is normalized to U+00E9.
+	s = "é"
+	assert.NoError(v.Validate(&s, normalize.NFC, is.NFC))
+	assert.Equal("é", s)
+
+	type Name string
+	name := Name(" a ")
+	assert.NoError(v.Validate(&name, normalize.Trim))
+	assert.Equal(Name("a"), name)
+
+	ptr := henge.ToStringPtr(" a ")
+	assert.NoError(v.Validate(&ptr, normalize.Trim))
+	assert.Equal("a", *ptr)
+
+	ptr = nil
+	assert.NoError(v.Validate(&ptr, normalize.Trim))
+	assert.Nil(ptr)
+
+	i := 1
+	assert.EqualError(
+		v.Validate(&i, normalize.Trim),
+		"(conversion) can not normalize the value that is not a string",
+	)
+}
+
+func TestDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name  string
+		Age   int64
+		Email *string
+	}
+
+	user := User{}
+	assert.NoError(
+		v.Validate(&user,
+			valis.Field(&user.Name, normalize.Default("anonymous")),
+			valis.Field(&user.Age, normalize.Default(20)),
+			valis.Field(&user.Email, normalize.Default("a@example.com"), is.Required),
+		),
+	)
+	assert.Equal(User{Name: "anonymous", Age: 20, Email: henge.ToStringPtr("a@example.com")}, user)
+
+	// NOTE: it does not overwrite the non-zero value.
+	user = User{Name: "taro", Age: 1, Email: henge.ToStringPtr("")}
+	assert.NoError(
+		v.Validate(&user,
+			valis.Field(&user.Name, normalize.Default("anonymous")),
+			valis.Field(&user.Age, normalize.Default(20)),
+			valis.Field(&user.Email, normalize.Default("a@example.com")),
+		),
+	)
+	assert.Equal(User{Name: "taro", Age: 1, Email: henge.ToStringPtr("")}, user)
+
+	user = User{}
+	assert.EqualError(
+		v.Validate(&user, valis.Field(&user.Name, normalize.Default(1))),
+		"(conversion) .Name can not convert from int to string",
+	)
+}
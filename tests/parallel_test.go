@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/normalize"
+	"github.com/soranoba/valis/tagrule"
+	"github.com/soranoba/valis/when"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelEach(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.EqualError(
+		v.Validate([]string{"a", "", "b", ""}, valis.ParallelEach(is.NonZero)),
+		"(non_zero) [1] can't be blank (or zero)\n(non_zero) [3] can't be blank (or zero)",
+	)
+	assert.NoError(v.Validate([]string{"a", "b", "c"}, valis.ParallelEach(is.NonZero)))
+	assert.NoError(v.Validate([]string{}, valis.ParallelEach(is.NonZero)))
+	assert.EqualError(
+		v.Validate(&[...]int{1, 0, 2}, valis.ParallelEach(is.NonZero)),
+		"(non_zero) [1] can't be blank (or zero)",
+	)
+
+	// NOTE: value must be array or slice.
+	assert.EqualError(v.Validate("", valis.ParallelEach(is.NonZero)), "(not_array) must be any array")
+
+	// NOTE: CommonRules automatically check.
+	v := valis.NewValidator()
+	v.SetCommonRules(is.NonZero)
+	assert.EqualError(v.Validate([]string{""}, valis.ParallelEach()), "(non_zero) [0] can't be blank (or zero)")
+}
+
+func TestParallelEach_sameAsEach(t *testing.T) {
+	assert := assert.New(t)
+
+	type Item struct {
+		Name  string `validate:"min=1,max=3"`
+		Price int    `validate:"gte=0"`
+	}
+	items := make([]Item, 1000)
+	for i := range items {
+		items[i] = Item{Name: fmt.Sprintf("%d", i), Price: i%7 - 3}
+	}
+
+	v := valis.NewValidator()
+	v.SetCommonRules(when.IsStruct(valis.EachFields(tagrule.Validate)))
+	v.SetConcurrency(4)
+
+	expected := v.Validate(items, valis.Each())
+	assert.Error(expected)
+	assert.EqualError(v.Validate(items, valis.ParallelEach()), expected.Error())
+
+	// NOTE: the first errors are the same as Each, even if it stops on the way.
+	v.SetMaxErrors(5)
+	expected = v.Validate(items, valis.Each())
+	assert.Error(expected)
+	assert.EqualError(v.Validate(items, valis.ParallelEach()), expected.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.EqualError(
+		v.ValidateContext(ctx, items, valis.ParallelEach()),
+		"(canceled) validation was canceled (context canceled)",
+	)
+}
+
+func TestParallelEachValues(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: the errors are sorted by the key.
+	assert.EqualError(
+		v.Validate(map[string]string{"c": "", "a": "", "b": "b"}, valis.ParallelEachValues(is.NonZero)),
+		"(non_zero) [a] can't be blank (or zero)\n(non_zero) [c] can't be blank (or zero)",
+	)
+	assert.EqualError(
+		v.Validate(map[int]int{10: 0, 2: 0, 1: 1}, valis.ParallelEachValues(is.NonZero)),
+		"(non_zero) [2] can't be blank (or zero)\n(non_zero) [10] can't be blank (or zero)",
+	)
+	assert.NoError(v.Validate(map[string]string{"a": "a"}, valis.ParallelEachValues(is.NonZero)))
+
+	// NOTE: value must be map.
+	assert.EqualError(v.Validate("", valis.ParallelEachValues(is.NonZero)), "(not_map) must be any map")
+
+	// NOTE: CommonRules automatically check.
+	v := valis.NewValidator()
+	v.SetCommonRules(is.NonZero)
+	assert.EqualError(
+		v.Validate(map[string]string{"a": ""}, valis.ParallelEachValues()),
+		"(non_zero) [a] can't be blank (or zero)",
+	)
+}
+
+func TestParallelEachValues_transform(t *testing.T) {
+	assert := assert.New(t)
+
+	m := make(map[string]string)
+	for i := 0; i < 100; i++ {
+		m[fmt.Sprintf("k%d", i)] = fmt.Sprintf(" v%d ", i)
+	}
+
+	v := valis.NewValidator()
+	v.SetConcurrency(4)
+	assert.NoError(v.Validate(m, valis.ParallelEachValues(normalize.Trim, is.LengthBetween(2, 3))))
+	for i := 0; i < 100; i++ {
+		assert.Equal(fmt.Sprintf("v%d", i), m[fmt.Sprintf("k%d", i)])
+	}
+
+	// NOTE: the values are the same as EachValues.
+	m1, m2 := map[string]string{"a": " X "}, map[string]string{"a": " X "}
+	assert.NoError(valis.Validate(m1, valis.EachValues(normalize.Trim)))
+	assert.NoError(valis.Validate(m2, valis.ParallelEachValues(normalize.Trim)))
+	assert.Equal(m1, m2)
+}
+
+type panicRule struct{}
+
+func (r *panicRule) Validate(validator *valis.Validator, value interface{}) {
+	if value == 2 {
+		panic("boom")
+	}
+}
+
+func TestParallelEach_panic(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: the panic in the workers is raised on the calling goroutine, in the same way as Each.
+	v := valis.NewValidator()
+	v.SetConcurrency(2)
+	assert.PanicsWithValue("boom", func() {
+		_ = v.Validate([]int{1, 2, 3}, valis.ParallelEach(&panicRule{}))
+	})
+	assert.PanicsWithValue("boom", func() {
+		_ = v.Validate(map[string]int{"a": 1, "b": 2}, valis.ParallelEachValues(&panicRule{}))
+	})
+}
+
+func TestParallelEach_errorCollectorFactory(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE: the ErrorCollector of each element is created by the ErrorCollectorFactoryFunc.
+	var count int64
+	v := valis.NewValidator()
+	v.SetConcurrency(2)
+	v.SetErrorCollectorFactoryFunc(func() valis.ErrorCollector {
+		atomic.AddInt64(&count, 1)
+		return valis.NewStandardErrorCollector(valis.JSONPointerLocationNameResolver)
+	})
+	assert.EqualError(
+		v.Validate([]string{"a", "", "b"}, valis.ParallelEach(is.NonZero)),
+		"(non_zero) /1 can't be blank (or zero)",
+	)
+	assert.Equal(int64(4), atomic.LoadInt64(&count))
+}
@@ -0,0 +1,72 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/problem"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	err := valis.Validate([]string{"", "a"}, valis.Each(is.NonZero, is.In("a")))
+	d := problem.New(err, nil)
+	assert.Equal(problem.DefaultType, d.Type)
+	assert.Equal(http.StatusUnprocessableEntity, d.Status)
+	assert.Equal([]*valis.ErrorEntry{
+		{Pointer: "/0", Code: "non_zero", Message: "can't be blank (or zero)", Params: []interface{}{}},
+		{Pointer: "/0", Code: "inclusion", Message: "is not included in [a]", Params: []interface{}{[]interface{}{"a"}}},
+	}, d.Errors)
+
+	d = problem.New(errors.New("something wrong"), nil)
+	assert.Equal("something wrong", d.Detail)
+	assert.Nil(d.Errors)
+}
+
+func TestDetails_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	d := &problem.Details{
+		Title:  "Invalid request",
+		Status: http.StatusBadRequest,
+		Extensions: map[string]interface{}{
+			"trace_id": "abc",
+			"title":    "ignored",
+			"errors":   "ignored",
+		},
+	}
+	b, err := json.Marshal(d)
+	if assert.NoError(err) {
+		assert.JSONEq(`{"type":"about:blank","title":"Invalid request","status":400,"trace_id":"abc"}`, string(b))
+	}
+
+	d = problem.New(valis.Validate("", is.NonZero), nil)
+	b, err = json.Marshal(d)
+	if assert.NoError(err) {
+		assert.JSONEq(
+			`{"type":"about:blank","title":"Unprocessable Entity","status":422,`+
+				`"errors":[{"pointer":"","code":"non_zero","message":"can't be blank (or zero)"}]}`,
+			string(b),
+		)
+	}
+}
+
+func TestDetails_Write(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	d := problem.New(valis.Validate("", is.NonZero), nil)
+	if assert.NoError(d.Write(w)) {
+		assert.Equal(http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(problem.ContentType, w.Header().Get("Content-Type"))
+		b, _ := json.Marshal(d)
+		assert.Equal(string(b), w.Body.String())
+	}
+}
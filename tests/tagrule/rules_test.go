@@ -3,6 +3,7 @@ package tagrule_test
 import (
 	"github.com/soranoba/valis/when"
 	"testing"
+	"time"
 
 	"github.com/soranoba/henge/v2"
 	"github.com/soranoba/valis"
@@ -311,7 +312,7 @@ func TestValidate_len(t *testing.T) {
 			Params: map[string]string{"a": "", "b": "", "c": ""},
 		}, valis.EachFields(tagrule.Validate)),
 		`(too_short_length) .Name is too short length (minimum is 5 characters)
-(not_iterable) .Age not_iterable
+(not_iterable) .Age must be any iterable value
 (too_short_len) .Tags is too few elements (minimum is 5 elements)
 (too_short_len) .Params is too few elements (minimum is 5 elements)`,
 	)
@@ -394,3 +395,344 @@ func TestValidate_url(t *testing.T) {
 (invalid_scheme) .U3 which scheme is not included in [scp]`,
 	)
 }
+
+func TestValidate_field(t *testing.T) {
+	assert := assert.New(t)
+	type Event struct {
+		Name      string
+		Title     string `validate:"nefield=Name"`
+		StartDate time.Time
+		EndDate   *time.Time `validate:"gtfield=StartDate"`
+		Password  string     `validate:"ltfield=Name"`
+		Confirm   string     `validate:"eqfield=Password"`
+	}
+
+	now := time.Now()
+	end := now.Add(time.Hour)
+	assert.NoError(
+		v.Validate(Event{Name: "b", Title: "t", StartDate: now, EndDate: &end, Password: "a", Confirm: "a"}, valis.EachFields(tagrule.Validate)),
+	)
+	assert.NoError(
+		v.Validate(&Event{Name: "b", Title: "t", StartDate: now, Password: "a", Confirm: "a"}, valis.EachFields(tagrule.Validate)),
+	)
+
+	end = now
+	assert.EqualError(
+		v.Validate(Event{Name: "b", Title: "b", StartDate: now, EndDate: &end, Password: "c", Confirm: "b"}, valis.EachFields(tagrule.Validate)),
+		"(nefield) .Title must not be equal to Name\n"+
+			"(gtfield) .EndDate must be greater than StartDate\n"+
+			"(ltfield) .Password must be less than Name\n"+
+			"(eqfield) .Confirm must be equal to Password",
+	)
+
+	// NOTE: the invalid field names are detected when the tag is parsed, and the unknown fields are detected when validating.
+	type Unknown struct {
+		Confirm string `validate:"eqfield=Passwrod"`
+	}
+	assert.EqualError(
+		v.Validate(Unknown{}, valis.EachFields(tagrule.Validate)),
+		"(no_field) .Confirm refers to the unknown field (Passwrod)",
+	)
+	type Invalid struct {
+		Confirm string `validate:"eqfield=password"`
+	}
+	assert.PanicsWithValue(
+		"invalid field name (password) (key = validate, path = )",
+		func() { _ = v.Validate(Invalid{}, valis.EachFields(tagrule.Validate)) },
+	)
+	type TooMany struct {
+		Confirm string `validate:"eqfield=Password Name"`
+	}
+	assert.PanicsWithValue(
+		"too many tag parameters (key = validate, path = )",
+		func() { _ = v.Validate(TooMany{}, valis.EachFields(tagrule.Validate)) },
+	)
+}
+
+func TestValidate_required_if(t *testing.T) {
+	assert := assert.New(t)
+	type User struct {
+		Role   string
+		Email  string
+		Phone  string `validate:"required_without=Email"`
+		Team   string `validate:"required_if=Role  admin owner"`
+		Notify *bool  `validate:"required_with=Email"`
+		Guest  string `validate:"excluded_if=Role  admin"`
+	}
+
+	assert.NoError(
+		v.Validate(User{Role: "member", Phone: "0123"}, valis.EachFields(tagrule.Validate)),
+	)
+	// NOTE: the repeated spaces do not make the empty values.
+	assert.NoError(
+		v.Validate(User{Phone: "0123", Team: "a", Guest: "a"}, valis.EachFields(tagrule.Validate)),
+	)
+	assert.EqualError(
+		v.Validate(User{Role: "owner", Email: "a@example.com"}, valis.EachFields(tagrule.Validate)),
+		"(required_if) .Team is required when Role is owner\n"+
+			"(required_with) .Notify is required when Email is present",
+	)
+	assert.EqualError(
+		v.Validate(&User{Role: "admin", Team: "a", Guest: "a"}, valis.EachFields(tagrule.Validate)),
+		"(required_without) .Phone is required when Email is not present\n"+
+			"(excluded_if) .Guest must be blank when Role is admin",
+	)
+
+	// NOTE: the invalid field names are detected when the tag is parsed.
+	type InvalidIf struct {
+		Team string `validate:"required_if=role admin"`
+	}
+	assert.PanicsWithValue(
+		"invalid field name (role) (key = validate, path = )",
+		func() { _ = v.Validate(InvalidIf{}, valis.EachFields(tagrule.Validate)) },
+	)
+	type InvalidWith struct {
+		Notify *bool `validate:"required_with=Email phone"`
+	}
+	assert.PanicsWithValue(
+		"invalid field name (phone) (key = validate, path = )",
+		func() { _ = v.Validate(InvalidWith{}, valis.EachFields(tagrule.Validate)) },
+	)
+}
+
+func TestValidate_time(t *testing.T) {
+	assert := assert.New(t)
+	type Event struct {
+		StartAt  time.Time     `validate:"after=2021-01-01,before=2022-01-01T00:00:00Z"`
+		EndAt    *string       `validate:"future"`
+		OpenedAt time.Time     `validate:"past,weekday=Mon  fri"`
+		Timeout  time.Duration `validate:"duration=1s 1m"`
+	}
+
+	friday := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(
+		v.Validate(Event{StartAt: friday.Add(time.Hour), OpenedAt: friday, Timeout: time.Second}, valis.EachFields(tagrule.Validate)),
+	)
+	assert.EqualError(
+		v.Validate(Event{StartAt: friday, EndAt: henge.ToStringPtr("2021-01-01"), OpenedAt: friday.Add(24 * time.Hour), Timeout: time.Hour}, valis.EachFields(tagrule.Validate)),
+		"(after) .StartAt must be after 2021-01-01 00:00:00 +0000 UTC\n"+
+			"(future) .EndAt must be in the future\n"+
+			"(weekday) .OpenedAt must be one of the weekdays [Monday Friday]\n"+
+			"(duration_out_of_range) .Timeout must be between 1s and 1m0s",
+	)
+	assert.Panics(func() {
+		type Invalid struct {
+			At time.Time `validate:"weekday= "`
+		}
+		_ = v.Validate(Invalid{}, valis.EachFields(tagrule.Validate))
+	})
+	assert.Panics(func() {
+		type Invalid struct {
+			At time.Time `validate:"weekday=Someday"`
+		}
+		_ = v.Validate(Invalid{}, valis.EachFields(tagrule.Validate))
+	})
+
+	type Spaces struct {
+		Timeout time.Duration `validate:"duration=1s  1h"`
+	}
+	assert.NoError(v.Validate(Spaces{Timeout: time.Minute}, valis.EachFields(tagrule.Validate)))
+	assert.EqualError(
+		v.Validate(Spaces{Timeout: 2 * time.Hour}, valis.EachFields(tagrule.Validate)),
+		"(duration_out_of_range) .Timeout must be between 1s and 1h0m0s",
+	)
+	type TooMany struct {
+		Timeout time.Duration `validate:"duration=1s 1m 1h"`
+	}
+	assert.PanicsWithValue(
+		"too many tag parameters (key = validate, path = )",
+		func() { _ = v.Validate(TooMany{}, valis.EachFields(tagrule.Validate)) },
+	)
+}
+
+func TestValidate_format(t *testing.T) {
+	assert := assert.New(t)
+	type Server struct {
+		ID       string  `validate:"uuid"`
+		Host     string  `validate:"hostname"`
+		Address  *string `validate:"ipv4"`
+		Network  string  `validate:"cidr"`
+		Version  string  `validate:"semver"`
+		Color    string  `validate:"hexcolor"`
+		Country  string  `validate:"iso3166_1_alpha2"`
+		Currency string  `validate:"iso4217"`
+		Language string  `validate:"bcp47_language_tag"`
+	}
+
+	assert.NoError(
+		v.Validate(Server{
+			ID:       "123e4567-e89b-12d3-a456-426614174000",
+			Host:     "example.com",
+			Network:  "192.0.2.0/24",
+			Version:  "1.0.0",
+			Color:    "#fff",
+			Country:  "JP",
+			Currency: "JPY",
+			Language: "ja-JP",
+		}, valis.EachFields(tagrule.Validate)),
+	)
+	assert.EqualError(
+		v.Validate(Server{Address: henge.ToStringPtr("::1")}, valis.EachFields(tagrule.Validate)),
+		"(invalid_uuid) .ID is an invalid UUID\n"+
+			"(invalid_hostname) .Host is an invalid hostname\n"+
+			"(invalid_ipv4) .Address is an invalid IPv4 address\n"+
+			"(invalid_cidr) .Network is an invalid CIDR notation\n"+
+			"(invalid_semver) .Version is an invalid semantic version\n"+
+			"(invalid_hex_color) .Color is an invalid hex color code\n"+
+			"(invalid_country_code) .Country is an invalid country code\n"+
+			"(invalid_currency_code) .Currency is an invalid currency code\n"+
+			"(invalid_language_tag) .Language is an invalid language tag",
+	)
+}
+
+func TestNormalize(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name  string  `normalize:"trim" validate:"max=4"`
+		Email *string `normalize:"trim,lower" validate:"required"`
+		Code  string  `normalize:"upper"`
+	}
+
+	user := User{Name: " taro ", Email: henge.ToStringPtr(" Taro@Example.com "), Code: "jp"}
+	assert.NoError(v.Validate(&user, valis.EachFields(tagrule.Normalize, tagrule.Validate)))
+	assert.Equal(User{Name: "taro", Email: henge.ToStringPtr("taro@example.com"), Code: "JP"}, user)
+
+	user = User{Name: " hanako "}
+	assert.EqualError(
+		v.Validate(&user, valis.EachFields(tagrule.Normalize, tagrule.Validate)),
+		"(too_long_length) .Name is too long length (maximum is 4 characters)\n"+
+			"(required) .Email is required",
+	)
+
+	// NOTE: the unknown normalizers are detected when the tag is parsed.
+	type Invalid struct {
+		Name string `normalize:"trimm"`
+	}
+	assert.PanicsWithValue(
+		"unknown normalizer (trimm) (key = normalize, path = )",
+		func() { _ = v.Validate(Invalid{}, valis.EachFields(tagrule.Normalize)) },
+	)
+}
+
+func TestDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	type Config struct {
+		Name    string        `default:"app" validate:"required"`
+		Port    int           `default:"8080"`
+		Ratio   *float64      `default:"0.5"`
+		Debug   bool          `default:"true"`
+		Timeout time.Duration `default:"1m30s"`
+		Hosts   []string      `default:"a,b"`
+		Ports   []uint16      `default:"80,443"`
+	}
+
+	config := Config{}
+	assert.NoError(v.Validate(&config, valis.EachFields(tagrule.Default, tagrule.Validate)))
+	assert.Equal(Config{
+		Name:    "app",
+		Port:    8080,
+		Ratio:   henge.ToFloatPtr(0.5),
+		Debug:   true,
+		Timeout: 90 * time.Second,
+		Hosts:   []string{"a", "b"},
+		Ports:   []uint16{80, 443},
+	}, config)
+
+	// NOTE: it does not overwrite the non-zero value, and the value does not have to be addressable in that case.
+	config = Config{Name: "x", Port: 1, Ratio: henge.ToFloatPtr(0), Debug: true, Timeout: 1, Hosts: []string{}, Ports: []uint16{1}}
+	assert.NoError(v.Validate(config, valis.EachFields(tagrule.Default)))
+
+	type Invalid struct {
+		Port int `default:"http"`
+	}
+	assert.EqualError(
+		v.Validate(&Invalid{}, valis.EachFields(tagrule.Default)),
+		"(conversion) .Port Failed to convert from string to int: fields=, value=\"http\", error=strconv.ParseInt: parsing \"http\": invalid syntax",
+	)
+	assert.EqualError(
+		v.Validate(Config{Name: "x"}, valis.EachFields(tagrule.Default)),
+		"(not_assignable) .Port can't assign to int\n"+
+			"(not_assignable) .Ratio can't assign to *float64\n"+
+			"(not_assignable) .Debug can't assign to bool\n"+
+			"(not_assignable) .Timeout can't assign to time.Duration\n"+
+			"(not_assignable) .Hosts can't assign to []string\n"+
+			"(not_assignable) .Ports can't assign to []uint16",
+	)
+
+	type Empty struct {
+		Name string `default:""`
+	}
+	assert.PanicsWithValue(
+		"insufficient number of tag parameters (key = default, path = )",
+		func() { v.Validate(&Empty{}, valis.EachFields(tagrule.Default)) },
+	)
+
+	// NOTE: the default values do not share the same slice.
+	config1, config2 := Config{}, Config{}
+	assert.NoError(v.Validate(&config1, valis.EachFields(tagrule.Default)))
+	assert.NoError(v.Validate(&config2, valis.EachFields(tagrule.Default)))
+	config1.Hosts[0] = "c"
+	assert.Equal([]string{"a", "b"}, config2.Hosts)
+
+	// NOTE: any number of pointer levels are supported, and the values do not share the same pointers.
+	type Pointers struct {
+		Port **int `default:"80"`
+	}
+	pointers1, pointers2 := Pointers{}, Pointers{}
+	assert.NoError(v.Validate(&pointers1, valis.EachFields(tagrule.Default)))
+	assert.NoError(v.Validate(&pointers2, valis.EachFields(tagrule.Default)))
+	assert.Equal(80, **pointers1.Port)
+	**pointers1.Port = 8080
+	assert.Equal(80, **pointers2.Port)
+
+	// NOTE: the tag value is converted to the type of the value, when the field is an interface.
+	type Any struct {
+		Value interface{} `default:"1"`
+	}
+	anyValue := Any{Value: 0}
+	assert.NoError(v.Validate(&anyValue, valis.EachFields(tagrule.Default)))
+	assert.Equal(Any{Value: 1}, anyValue)
+}
+
+func TestValidate_deprecated(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Nickname string `validate:"deprecated"`
+	}
+
+	assert.NoError(v.Validate(&User{Nickname: "taro"}, valis.EachFields(tagrule.Validate)))
+	warnings, err := v.ValidateWithWarnings(&User{Nickname: "taro"}, valis.EachFields(tagrule.Validate))
+	assert.NoError(err)
+	assert.EqualError(warnings, "(deprecated) [warning] .Nickname is deprecated")
+
+	warnings, err = v.ValidateWithWarnings(&User{}, valis.EachFields(tagrule.Validate))
+	assert.NoError(warnings)
+	assert.NoError(err)
+}
+
+func TestWithMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Username string  `validate:"min=3,max=20" msg:"must be 3-20 characters"`
+		Email    *string `required:"true"`
+	}
+
+	assert.NoError(v.Validate(&User{Username: "taro", Email: henge.ToStringPtr("a")}, valis.EachFields(tagrule.WithMessage(tagrule.Required, tagrule.Validate))))
+	assert.EqualError(
+		v.Validate(&User{Username: "a"}, valis.EachFields(tagrule.WithMessage(tagrule.Required, tagrule.Validate))),
+		"(too_short_length) .Username must be 3-20 characters\n"+
+			"(required) .Email is required",
+	)
+	// NOTE: the message of the tag is not a format.
+	type Percent struct {
+		Ratio int `validate:"max=100" msg:"must be 100% or less"`
+	}
+	assert.EqualError(
+		v.Validate(&Percent{Ratio: 101}, valis.EachFields(tagrule.WithMessage(tagrule.Validate))),
+		"(lte) .Ratio must be 100% or less",
+	)
+}
@@ -0,0 +1,42 @@
+package to_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/to"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime(t *testing.T) {
+	assert := assert.New(t)
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(
+		v.Validate("2021-01-01T09:00:00+09:00", to.Time(is.TimeBetween(day, day))),
+	)
+	assert.NoError(
+		v.Validate("2021-01-01", to.Time(is.TimeBetween(day, day))),
+	)
+	assert.NoError(
+		v.Validate(&day, to.Time(is.TimeBetween(day, day))),
+	)
+	assert.EqualError(
+		v.Validate("2021/01/01", to.Time()),
+		"(conversion) can not parse as time.Time (2006-01-02T15:04:05Z07:00, 2006-01-02)",
+	)
+	assert.EqualError(
+		v.Validate(1, to.Time()),
+		"(conversion) can not convert from int to time.Time",
+	)
+	assert.EqualError(
+		v.Validate((*time.Time)(nil), to.Time()),
+		"(conversion) can not convert to time.Time",
+	)
+
+	// NOTE: the layouts can be changed.
+	assert.NoError(
+		v.Validate("2021/01/01", to.TimeWithLayouts("2006/01/02")(is.TimeBetween(day, day))),
+	)
+}
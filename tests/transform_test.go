@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransform(t *testing.T) {
+	assert := assert.New(t)
+
+	trim := valis.Transform(func(value interface{}) (interface{}, error) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("not a string")
+		}
+		return strings.TrimSpace(s), nil
+	})
+
+	type User struct {
+		Name  string
+		Tags  []string
+		Attrs map[string]string
+	}
+
+	// NOTE: the subsequent rules verify the transformed value.
+	user := User{Name: " a ", Tags: []string{" b "}, Attrs: map[string]string{"c": " c "}}
+	assert.NoError(
+		v.Validate(&user,
+			valis.Field(&user.Name, trim, is.LengthBetween(1, 1)),
+			valis.Field(&user.Tags, valis.Each(trim, is.LengthBetween(1, 1))),
+			valis.Field(&user.Attrs, valis.Key("c", trim, is.LengthBetween(1, 1))),
+		),
+	)
+	assert.Equal(User{Name: "a", Tags: []string{"b"}, Attrs: map[string]string{"c": "c"}}, user)
+
+	user = User{Name: " a ", Attrs: map[string]string{"c": " c "}}
+	assert.NoError(
+		v.Validate(&user, valis.EachFields(
+			valis.When(func(ctx *valis.WhenContext) bool { return ctx.Location().Field().Name == "Name" }, trim),
+		), valis.Field(&user.Attrs, valis.EachValues(trim))),
+	)
+	assert.Equal(User{Name: "a", Attrs: map[string]string{"c": "c"}}, user)
+
+	// NOTE: the pointer at the root location can be written.
+	s := " a "
+	assert.NoError(v.Validate(&s, valis.Transform(func(value interface{}) (interface{}, error) {
+		return strings.TrimSpace(*value.(*string)), nil
+	}), is.LengthBetween(1, 1)))
+	assert.Equal("a", s)
+
+	// NOTE: the value is not addressable.
+	assert.EqualError(
+		v.Validate(User{Name: " a "}, valis.EachFields(trim)),
+		"(not_assignable) .Name can't assign to string\n"+
+			"(conversion) .Tags not a string\n"+
+			"(conversion) .Attrs not a string",
+	)
+	assert.EqualError(
+		v.Validate(" a ", trim),
+		"(not_assignable) can't assign to string",
+	)
+	// NOTE: the type of the transformed value is not assignable.
+	assert.EqualError(
+		v.Validate(&s, valis.Transform(func(value interface{}) (interface{}, error) { return 1, nil })),
+		"(not_assignable) can't assign to *string",
+	)
+}
@@ -0,0 +1,166 @@
+package translations_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/soranoba/valis/code"
+	"github.com/soranoba/valis/translations"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func TestGotextFS(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"locales/ja/out.gotext.json": &fstest.MapFile{Data: []byte(`{
+  "language": "ja",
+  "messages": [
+    {
+      "id": "required",
+      "message": "required",
+      "translation": "は必ず入力してください"
+    },
+    {
+      "id": ["too_long_len", "{Max} elements"],
+      "key": "too_long_len",
+      "message": "too_long_len",
+      "translation": {
+        "select": {
+          "feature": "plural",
+          "arg": "Max",
+          "cases": {
+            "=1": "は1要素だけです",
+            "other": "は{Max}要素までです"
+          }
+        }
+      },
+      "placeholders": [
+        {"id": "Max", "string": "%[1]d", "type": "int", "underlyingType": "int", "argNum": 1, "expr": "max"}
+      ]
+    },
+    {
+      "id": "invalid",
+      "message": "invalid",
+      "translation": ""
+    }
+  ]
+}`)},
+		"locales/fr/out.gotext.json": &fstest.MapFile{Data: []byte(`{
+  "language": "fr",
+  "messages": [
+    {"id": "required", "message": "required", "translation": "est requis"}
+  ]
+}`)},
+	}
+
+	f, err := translations.GotextFS(fsys, "locales/*/out.gotext.json")
+	if !assert.NoError(err) {
+		return
+	}
+	c := translations.NewCatalog()
+	c.Set(f)
+
+	ja := message.NewPrinter(language.Japanese, message.Catalog(c))
+	assert.Equal("は必ず入力してください", ja.Sprintf(code.Required))
+	assert.Equal("は1要素だけです", ja.Sprintf(code.TooLongLen, 1))
+	assert.Equal("は5要素までです", ja.Sprintf(code.TooLongLen, 5))
+	// NOTE: untranslated messages are not registered.
+	assert.Equal(code.Invalid, ja.Sprintf(code.Invalid))
+
+	fr := message.NewPrinter(language.French, message.Catalog(c))
+	assert.Equal("est requis", fr.Sprintf(code.Required))
+
+	// override the predefined translations
+	c = translations.NewCatalog()
+	c.Set(translations.DefaultJapanese)
+	c.Set(f)
+	ja = message.NewPrinter(language.Japanese, message.Catalog(c))
+	assert.Equal("は必ず入力してください", ja.Sprintf(code.Required))
+	assert.Equal("は不正な値です", ja.Sprintf(code.Invalid))
+}
+
+func TestGotextFS_error(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := translations.GotextFS(fstest.MapFS{
+		"ja.json": &fstest.MapFile{Data: []byte(`{"language": "ja", "messages": [`)},
+	}, "*.json")
+	assert.Error(err)
+
+	_, err = translations.GotextFS(fstest.MapFS{
+		"ja.json": &fstest.MapFile{Data: []byte(`{"language": "ja", "messages": [
+  {"id": "too_long_len", "translation": {"select": {"feature": "plural", "arg": "Max", "cases": {"other": "..."}}}}
+]}`)},
+	}, "*.json")
+	assert.EqualError(err, "ja.json: too_long_len: unknown select arg: Max")
+
+	_, err = translations.GotextFS(fstest.MapFS{
+		"ja.json": &fstest.MapFile{Data: []byte(`{"language": "ja", "messages": [
+  {"id": "too_long_len", "translation": {"select": {"feature": "gender", "arg": "1", "cases": {"other": "..."}}}}
+]}`)},
+	}, "*.json")
+	assert.EqualError(err, "ja.json: too_long_len: unsupported select feature: gender")
+}
+
+func TestMessagesFS(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"locales/pt-BR.json": &fstest.MapFile{Data: []byte(`{
+  "required": "é necessário",
+  "too_long_length": {
+    "select": {
+      "feature": "plural",
+      "arg": "1",
+      "cases": {
+        "one": "é longo demais (máximo de %[1]d caractere)",
+        "other": "é longo demais (máximo de %[1]d caracteres)"
+      }
+    }
+  },
+  "too_short_length": {
+    "msg": "é curto demais (mínimo de %[1]d ${characters})",
+    "var": {
+      "characters": {
+        "select": {"feature": "plural", "arg": "1", "cases": {"one": "caractere", "other": "caracteres"}}
+      }
+    }
+  }
+}`)},
+		"locales/ja.json": &fstest.MapFile{Data: []byte(`{"required": "は必ず入力してください"}`)},
+	}
+
+	f, err := translations.MessagesFS(fsys, "locales/*.json")
+	if !assert.NoError(err) {
+		return
+	}
+	c := translations.NewCatalog()
+	c.Set(f)
+
+	pt := message.NewPrinter(language.BrazilianPortuguese, message.Catalog(c))
+	assert.Equal("é necessário", pt.Sprintf(code.Required))
+	assert.Equal("é longo demais (máximo de 1 caractere)", pt.Sprintf(code.TooLongLength, 1))
+	assert.Equal("é longo demais (máximo de 2 caracteres)", pt.Sprintf(code.TooLongLength, 2))
+	assert.Equal("é curto demais (mínimo de 1 caractere)", pt.Sprintf(code.TooShortLength, 1))
+	assert.Equal("é curto demais (mínimo de 3 caracteres)", pt.Sprintf(code.TooShortLength, 3))
+
+	ja := message.NewPrinter(language.Japanese, message.Catalog(c))
+	assert.Equal("は必ず入力してください", ja.Sprintf(code.Required))
+}
+
+func TestMessagesFS_error(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := translations.MessagesFS(fstest.MapFS{
+		"unknown-language-name.json": &fstest.MapFile{Data: []byte(`{}`)},
+	}, "*.json")
+	assert.Error(err)
+
+	_, err = translations.MessagesFS(fstest.MapFS{
+		"ja.json": &fstest.MapFile{Data: []byte(`{"too_long_len": {"select": {"feature": "plural", "arg": "1", "cases": {"several": "..."}}}}`)},
+	}, "*.json")
+	assert.EqualError(err, "ja.json: too_long_len: invalid plural selector: several")
+}
@@ -6,9 +6,15 @@ import (
 	"github.com/soranoba/valis/code"
 	"github.com/soranoba/valis/translations"
 	"github.com/stretchr/testify/assert"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -34,40 +40,108 @@ func TestTranslations(t *testing.T) {
 
 	en := language.English
 	ja := language.Japanese
+	de := language.German
+	fr := language.French
+	es := language.Spanish
+	ptBR := language.BrazilianPortuguese
+	zh := language.SimplifiedChinese
+	ko := language.Korean
 
 	data := []*Data{
 		f(code.NotString)(Results{
-			en: "must be any string",
-			ja: "must be any string",
+			en:   "must be any string",
+			ja:   "は文字列である必要があります",
+			de:   "muss eine Zeichenkette sein",
+			fr:   "doit être une chaîne de caractères",
+			es:   "debe ser una cadena",
+			ptBR: "deve ser uma string",
+			zh:   "必须是字符串",
+			ko:   "문자열이어야 합니다",
 		}),
 		f(code.NotStruct)(Results{
 			en: "must be any struct",
-			ja: "must be any struct",
+			ja: "は構造体である必要があります",
 		}),
 		f(code.NotStructField)(Results{
 			en: "must be any struct field",
-			ja: "must be any struct field",
+			ja: "は構造体のフィールドである必要があります",
 		}),
 		f(code.NotArray)(Results{
 			en: "must be any array",
-			ja: "must be any array",
+			ja: "は配列である必要があります",
 		}),
 		f(code.NotMap)(Results{
 			en: "must be any map",
-			ja: "must be any map",
+			ja: "はマップである必要があります",
+		}),
+		f(code.NotNumeric)(Results{
+			en: "must be any number",
+			ja: "は数値である必要があります",
+		}),
+		f(code.NotInteger)(Results{
+			en: "must be any integer",
+			ja: "は整数である必要があります",
+		}),
+		f(code.NotBoolean)(Results{
+			en: "must be any boolean",
+			ja: "は真偽値である必要があります",
+		}),
+		f(code.NotTime)(Results{
+			en: "must be any time",
+			ja: "は日時である必要があります",
+		}),
+		f(code.NotDuration)(Results{
+			en: "must be any duration",
+			ja: "は期間である必要があります",
+		}),
+		f(code.NotNull)(Results{
+			en: "must be null",
+			ja: "はnullである必要があります",
+		}),
+		f(code.NotIterable)(Results{
+			en: "must be any iterable value",
+			ja: "は反復可能な値である必要があります",
 		}),
 		f(code.NotAssignable, "string")(Results{
 			en: "can't assign to string",
-			ja: "can't assign to string",
+			ja: "はstringに代入できません",
 		}),
 		f(code.NoKey, "name")(Results{
-			en: "requires the value at the key (name)",
-			ja: "requires the value at the key (name)",
+			en:   "requires the value at the key (name)",
+			ja:   "にはキー (name) の値が必要です",
+			de:   "benötigt einen Wert für den Schlüssel (name)",
+			fr:   "nécessite une valeur pour la clé (name)",
+			es:   "requiere el valor de la clave (name)",
+			ptBR: "requer o valor da chave (name)",
+			zh:   "需要键 (name) 的值",
+			ko:   "키 (name)의 값이 필요합니다",
+		}),
+		f(code.NoField, "Name")(Results{
+			en: "refers to the unknown field (Name)",
+			ja: "は存在しないフィールド (Name) を参照しています",
 		}),
 		f(code.ConversionFailed, errors.New("can't convert to string"))(Results{
 			en: "can't convert to string",
 			ja: "can't convert to string",
 		}),
+		f(code.Canceled, errors.New("context canceled"))(Results{
+			en:   "validation was canceled (context canceled)",
+			ja:   "検証が中断されました (context canceled)",
+			de:   "die Validierung wurde abgebrochen (context canceled)",
+			fr:   "la validation a été annulée (context canceled)",
+			es:   "la validación fue cancelada (context canceled)",
+			ptBR: "a validação foi cancelada (context canceled)",
+			zh:   "验证已取消 (context canceled)",
+			ko:   "검증이 취소되었습니다 (context canceled)",
+		}),
+		f(code.MaxDepthExceeded, 10)(Results{
+			en: "exceeds the maximum depth (10)",
+			ja: "は最大の深さ (10) を超えています",
+		}),
+		f(code.CircularReference)(Results{
+			en: "is a circular reference",
+			ja: "は循環参照です",
+		}),
 		f(code.Custom, errors.New("has error occurred"))(Results{
 			en: "has error occurred",
 			ja: "has error occurred",
@@ -77,8 +151,14 @@ func TestTranslations(t *testing.T) {
 			ja: "は不正な値です",
 		}),
 		f(code.Required)(Results{
-			en: "is required",
-			ja: "は必須です",
+			en:   "is required",
+			ja:   "は必須です",
+			de:   "ist erforderlich",
+			fr:   "est obligatoire",
+			es:   "es obligatorio",
+			ptBR: "é obrigatório",
+			zh:   "是必填项",
+			ko:   "필수 항목입니다",
 		}),
 		f(code.NonZero)(Results{
 			en: "can't be blank (or zero)",
@@ -93,8 +173,14 @@ func TestTranslations(t *testing.T) {
 			ja: "は指定できません",
 		}),
 		f(code.TooLongLength, 10)(Results{
-			en: "is too long length (maximum is 10 characters)",
-			ja: "は10文字までです",
+			en:   "is too long length (maximum is 10 characters)",
+			ja:   "は10文字までです",
+			de:   "ist zu lang (maximal 10 Zeichen)",
+			fr:   "est trop long (maximum 10 caractères)",
+			es:   "es demasiado largo (máximo 10 caracteres)",
+			ptBR: "é muito longo (máximo de 10 caracteres)",
+			zh:   "最多10个字符",
+			ko:   "최대 10자까지입니다",
 		}),
 		f(code.TooShortLength, 10)(Results{
 			en: "is too short length (minimum is 10 characters)",
@@ -105,28 +191,46 @@ func TestTranslations(t *testing.T) {
 			ja: "は10要素までです",
 		}),
 		f(code.TooShortLen, 10)(Results{
-			en: "is too few elements (minimum is 10 elements)",
-			ja: "は10要素以上必要です",
+			en:   "is too few elements (minimum is 10 elements)",
+			ja:   "は10要素以上必要です",
+			de:   "hat zu wenige Elemente (mindestens 10 Elemente)",
+			fr:   "contient trop peu d'éléments (minimum 10 éléments)",
+			es:   "tiene muy pocos elementos (mínimo 10 elementos)",
+			ptBR: "tem elementos de menos (mínimo de 10 elementos)",
+			zh:   "至少需要10个元素",
+			ko:   "최소 10개의 요소가 필요합니다",
 		}),
 		f(code.GreaterThan, 10)(Results{
-			en: "must be greater than 10",
-			ja: "は10以上の値にする必要があります",
+			en:   "must be greater than 10",
+			ja:   "は10より大きい値にする必要があります",
+			de:   "muss größer als 10 sein",
+			fr:   "doit être supérieur à 10",
+			es:   "debe ser mayor que 10",
+			ptBR: "deve ser maior que 10",
+			zh:   "必须大于10",
+			ko:   "10보다 커야 합니다",
 		}),
 		f(code.LessThan, 10)(Results{
 			en: "must be less than 10",
-			ja: "は10以下の値にする必要があります",
+			ja: "は10より小さい値にする必要があります",
 		}),
 		f(code.GreaterThanOrEqual, 10)(Results{
 			en: "must be greater than or equal to 10",
-			ja: "は10より大きい値にする必要があります",
+			ja: "は10以上の値にする必要があります",
 		}),
 		f(code.LessThanOrEqual, 10)(Results{
 			en: "must be less than or equal to 10",
-			ja: "は10より小さい値にする必要があります",
+			ja: "は10以下の値にする必要があります",
 		}),
 		f(code.Inclusion, []interface{}{"male", "female"})(Results{
-			en: "is not included in [male female]",
-			ja: "は [male female] のいずれかである必要があります",
+			en:   "is not included in [male female]",
+			ja:   "は [male female] のいずれかである必要があります",
+			de:   "ist nicht in [male female] enthalten",
+			fr:   "n'est pas inclus dans [male female]",
+			es:   "no está incluido en [male female]",
+			ptBR: "não está incluído em [male female]",
+			zh:   "必须是 [male female] 中的一个",
+			ko:   "[male female] 중 하나여야 합니다",
 		}),
 		f(code.RegexpMismatch, regexp.MustCompile("^[0-9]+$").String())(Results{
 			en: "is a mismatch with the regular expression. (^[0-9]+$)",
@@ -144,6 +248,173 @@ func TestTranslations(t *testing.T) {
 			en: "is an invalid email address",
 			ja: "は不正なメールアドレスです",
 		}),
+		f(code.InvalidUUIDFormat)(Results{
+			en: "is an invalid UUID",
+			ja: "は不正なUUIDです",
+		}),
+		f(code.InvalidIPFormat)(Results{
+			en: "is an invalid IP address",
+			ja: "は不正なIPアドレスです",
+		}),
+		f(code.InvalidIPv4Format)(Results{
+			en: "is an invalid IPv4 address",
+			ja: "は不正なIPv4アドレスです",
+		}),
+		f(code.InvalidIPv6Format)(Results{
+			en: "is an invalid IPv6 address",
+			ja: "は不正なIPv6アドレスです",
+		}),
+		f(code.InvalidCIDRFormat)(Results{
+			en: "is an invalid CIDR notation",
+			ja: "は不正なCIDR表記です",
+		}),
+		f(code.InvalidMACFormat)(Results{
+			en: "is an invalid MAC address",
+			ja: "は不正なMACアドレスです",
+		}),
+		f(code.InvalidHostnameFormat)(Results{
+			en: "is an invalid hostname",
+			ja: "は不正なホスト名です",
+		}),
+		f(code.InvalidFQDNFormat)(Results{
+			en: "is an invalid fully qualified domain name",
+			ja: "は不正な完全修飾ドメイン名です",
+		}),
+		f(code.InvalidSemverFormat)(Results{
+			en: "is an invalid semantic version",
+			ja: "は不正なセマンティックバージョンです",
+		}),
+		f(code.InvalidBase64Format)(Results{
+			en: "is an invalid base64 string",
+			ja: "は不正なBase64文字列です",
+		}),
+		f(code.InvalidHexColorFormat)(Results{
+			en: "is an invalid hex color code",
+			ja: "は不正なカラーコードです",
+		}),
+		f(code.InvalidCountryCode)(Results{
+			en: "is an invalid country code",
+			ja: "は不正な国コードです",
+		}),
+		f(code.InvalidCurrencyCode)(Results{
+			en: "is an invalid currency code",
+			ja: "は不正な通貨コードです",
+		}),
+		f(code.InvalidLanguageTag)(Results{
+			en: "is an invalid language tag",
+			ja: "は不正な言語タグです",
+		}),
+		f(code.ASCIIOnly)(Results{
+			en: "must contain only ASCII characters",
+			ja: "はASCII文字のみ使用できます",
+		}),
+		f(code.PrintableOnly)(Results{
+			en: "must contain only printable characters",
+			ja: "は印字可能な文字のみ使用できます",
+		}),
+		f(code.AlphanumericOnly)(Results{
+			en: "must contain only letters and digits",
+			ja: "は文字と数字のみ使用できます",
+		}),
+		f(code.NoControlCharacters)(Results{
+			en: "must not contain control characters",
+			ja: "に制御文字を含めることはできません",
+		}),
+		f(code.NotNormalized, "NFC")(Results{
+			en: "must be normalized in NFC",
+			ja: "はNFCで正規化されている必要があります",
+		}),
+		f(code.TooLongGraphemeLength, 1)(Results{
+			en: "is too long length (maximum is 1 character)",
+			ja: "は1文字までです",
+		}),
+		f(code.TooShortGraphemeLength, 1)(Results{
+			en: "is too short length (minimum is 1 character)",
+			ja: "は1文字以上必要です",
+		}),
+		f(code.EqualField, "Password")(Results{
+			en: "must be equal to Password",
+			ja: "はPasswordと一致する必要があります",
+		}),
+		f(code.NotEqualField, "Password")(Results{
+			en: "must not be equal to Password",
+			ja: "はPasswordと異なる値にする必要があります",
+		}),
+		f(code.GreaterThanField, "StartDate")(Results{
+			en: "must be greater than StartDate",
+			ja: "はStartDateより大きい値にする必要があります",
+		}),
+		f(code.LessThanField, "EndDate")(Results{
+			en: "must be less than EndDate",
+			ja: "はEndDateより小さい値にする必要があります",
+		}),
+		f(code.RequiredIf, "Role", "admin")(Results{
+			en:   "is required when Role is admin",
+			ja:   "はRoleがadminの場合は必須です",
+			de:   "ist erforderlich, wenn Role gleich admin ist",
+			fr:   "est obligatoire lorsque Role vaut admin",
+			es:   "es obligatorio cuando Role es admin",
+			ptBR: "é obrigatório quando Role é admin",
+			zh:   "在Role为admin时是必填项",
+			ko:   "Role이(가) admin인 경우 필수 항목입니다",
+		}),
+		f(code.RequiredWith, "Email")(Results{
+			en: "is required when Email is present",
+			ja: "はEmailを指定する場合は必須です",
+		}),
+		f(code.RequiredWithout, "Email")(Results{
+			en: "is required when Email is not present",
+			ja: "はEmailを指定しない場合は必須です",
+		}),
+		f(code.ExcludedIf, "Role", "guest")(Results{
+			en: "must be blank when Role is guest",
+			ja: "はRoleがguestの場合は指定できません",
+		}),
+		f(code.Before, "2021-01-01")(Results{
+			en: "must be before 2021-01-01",
+			ja: "は2021-01-01より前である必要があります",
+		}),
+		f(code.After, "2021-01-01")(Results{
+			en: "must be after 2021-01-01",
+			ja: "は2021-01-01より後である必要があります",
+		}),
+		f(code.TimeOutOfRange, "2021-01-01", "2021-12-31")(Results{
+			en:   "must be between 2021-01-01 and 2021-12-31",
+			ja:   "は2021-01-01から2021-12-31の間である必要があります",
+			de:   "muss zwischen 2021-01-01 und 2021-12-31 liegen",
+			fr:   "doit être compris entre 2021-01-01 et 2021-12-31",
+			es:   "debe estar entre 2021-01-01 y 2021-12-31",
+			ptBR: "deve estar entre 2021-01-01 e 2021-12-31",
+			zh:   "必须介于2021-01-01和2021-12-31之间",
+			ko:   "2021-01-01에서 2021-12-31 사이여야 합니다",
+		}),
+		f(code.Future)(Results{
+			en: "must be in the future",
+			ja: "は未来の日時である必要があります",
+		}),
+		f(code.Past)(Results{
+			en: "must be in the past",
+			ja: "は過去の日時である必要があります",
+		}),
+		f(code.Weekday, "[Monday]")(Results{
+			en: "must be one of the weekdays [Monday]",
+			ja: "は [Monday] のいずれかの曜日である必要があります",
+		}),
+		f(code.DurationOutOfRange, "1s", "1m0s")(Results{
+			en: "must be between 1s and 1m0s",
+			ja: "は1sから1m0sの間である必要があります",
+		}),
+		// warning
+		f(code.Deprecated)(Results{
+			en:   "is deprecated",
+			ja:   "は非推奨です",
+			de:   "ist veraltet",
+			fr:   "est obsolète",
+			es:   "está obsoleto",
+			ptBR: "está obsoleto",
+			zh:   "已弃用",
+			ko:   "더 이상 사용되지 않습니다",
+		}),
 	}
 
 	c := translations.NewCatalog()
@@ -152,16 +423,152 @@ func TestTranslations(t *testing.T) {
 	}
 
 	for _, d := range data {
-		for _, lang := range c.Languages() {
+		for lang, result := range d.Results {
 			p := message.NewPrinter(lang, message.Catalog(c))
-			if !assert.Contains(d.Results, lang, fmt.Sprintf("%s does not have %s data", d.Code, lang.String())) {
-				continue
-			}
 			assert.Equal(
-				d.Results[lang],
+				result,
 				p.Sprintf(d.Code, d.Params...),
 				fmt.Sprintf("lang: %s, code: %s", lang.String(), d.Code),
 			)
 		}
 	}
 }
+
+func TestTranslations_completeness(t *testing.T) {
+	assert := assert.New(t)
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "../../code", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if !assert.NoError(err) {
+		return
+	}
+
+	codes := make([]string, 0)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					for _, value := range spec.(*ast.ValueSpec).Values {
+						if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							s, err := strconv.Unquote(lit.Value)
+							assert.NoError(err)
+							codes = append(codes, s)
+						}
+					}
+				}
+			}
+		}
+	}
+	assert.NotEmpty(codes)
+	codes = append(codes, translations.LabelTemplateKey)
+
+	for _, registerFunc := range translations.AllPredefinedCatalogRegistrationFunc {
+		c := translations.NewCatalog()
+		c.Set(registerFunc)
+		if !assert.Len(c.Languages(), 1) {
+			continue
+		}
+		lang := c.Languages()[0]
+
+		for _, code := range codes {
+			assert.NoError(
+				c.Context(lang, &discardRenderer{}).Execute(code),
+				fmt.Sprintf("lang: %s, code: %s", lang.String(), code),
+			)
+		}
+	}
+}
+
+func TestTranslations_plural(t *testing.T) {
+	assert := assert.New(t)
+
+	c := translations.NewCatalog()
+	for _, registerFunc := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(registerFunc)
+	}
+
+	data := map[language.Tag][2]string{
+		language.English:             {"is too long length (maximum is 1 character)", "is too long length (maximum is 2 characters)"},
+		language.German:              {"hat zu viele Elemente (maximal 1 Element)", "hat zu viele Elemente (maximal 2 Elemente)"},
+		language.French:              {"est trop long (maximum 1 caractère)", "est trop long (maximum 2 caractères)"},
+		language.Spanish:             {"es demasiado largo (máximo 1 carácter)", "es demasiado largo (máximo 2 caracteres)"},
+		language.BrazilianPortuguese: {"é muito longo (máximo de 1 caractere)", "é muito longo (máximo de 2 caracteres)"},
+	}
+	for lang, results := range data {
+		p := message.NewPrinter(lang, message.Catalog(c))
+		key := code.TooLongLength
+		if lang == language.German {
+			key = code.TooLongLen
+		}
+		assert.Equal(results[0], p.Sprintf(key, 1), lang.String())
+		assert.Equal(results[1], p.Sprintf(key, 2), lang.String())
+	}
+}
+
+// discardRenderer is a catalog.Renderer that discards the messages.
+type discardRenderer struct{}
+
+func (r *discardRenderer) Render(msg string) {}
+
+func (r *discardRenderer) Arg(i int) interface{} { return nil }
+
+func TestCatalog_Match(t *testing.T) {
+	assert := assert.New(t)
+
+	c := translations.NewCatalog()
+	for _, registerFunc := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(registerFunc)
+	}
+
+	assert.Equal(language.Japanese, c.Match(language.Japanese))
+	assert.Equal(language.Japanese, c.Match(language.MustParse("ja-JP")))
+	assert.Equal(language.BrazilianPortuguese, c.Match(language.MustParse("pt")))
+	assert.Equal(language.English, c.Match(language.MustParse("pt-PT")))
+	assert.Equal(language.English, c.Match(language.MustParse("zh-TW")))
+	assert.Equal(language.English, c.Match(language.MustParse("zh-Hant")))
+	assert.Equal(language.English, c.Match(language.MustParse("zh-HK")))
+	assert.Equal(language.SimplifiedChinese, c.Match(language.MustParse("zh")))
+	assert.Equal(language.SimplifiedChinese, c.Match(language.MustParse("zh-Hans")))
+	assert.Equal(language.SimplifiedChinese, c.Match(language.MustParse("zh-CN")))
+	assert.Equal(language.English, c.Match(language.MustParse("en-GB")))
+	assert.Equal(language.English, c.Match(language.Thai))
+	assert.Equal(language.English, c.Match())
+	assert.Equal(language.Korean, c.Match(language.Thai, language.Korean))
+
+	assert.Equal(language.French, c.MatchAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5"))
+	assert.Equal(language.German, c.MatchAcceptLanguage("th;q=0.9, de-AT;q=0.8"))
+	assert.Equal(language.English, c.MatchAcceptLanguage("zh-TW"))
+	assert.Equal(language.SimplifiedChinese, c.MatchAcceptLanguage("zh-TW, zh;q=0.9"))
+	assert.Equal(language.English, c.MatchAcceptLanguage("zh-Hant, en;q=0.9"))
+	assert.Equal(language.Japanese, c.MatchAcceptLanguage("pt-PT, ja;q=0.9"))
+	assert.Equal(language.English, c.MatchAcceptLanguage(""))
+	assert.Equal(language.English, c.MatchAcceptLanguage("invalid;;"))
+
+	// without English
+	c = translations.NewCatalog()
+	c.Set(translations.DefaultJapanese)
+	assert.Equal(language.Japanese, c.Match(language.French))
+
+	// empty
+	c = translations.NewCatalog()
+	assert.Equal(language.English, c.Match(language.French))
+}
+
+func TestCatalog_NewAcceptLanguagePrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	c := translations.NewCatalog()
+	for _, registerFunc := range translations.AllPredefinedCatalogRegistrationFunc {
+		c.Set(registerFunc)
+	}
+
+	assert.Equal("필수 항목입니다", c.NewAcceptLanguagePrinter("ko-KR,ko;q=0.9").Sprintf(code.Required))
+	assert.Equal("é obrigatório", c.NewAcceptLanguagePrinter("pt-PT,pt;q=0.9").Sprintf(code.Required))
+	assert.Equal("es obligatorio", c.NewPrinter(language.MustParse("es-MX")).Sprintf(code.Required))
+}
@@ -1,10 +1,17 @@
 package tests
 
 import (
+	"context"
+	"reflect"
+	"testing"
+
 	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/code"
 	"github.com/soranoba/valis/is"
+	"github.com/soranoba/valis/tagrule"
+	"github.com/soranoba/valis/to"
+	"github.com/soranoba/valis/when"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func TestValidator_Validate(t *testing.T) {
@@ -55,3 +62,348 @@ func TestValidator_SetCommonRules(t *testing.T) {
 	assert.Error(v1.Validate(""))
 	assert.NoError(v2.Validate(""))
 }
+
+type ctxKey struct{}
+
+type contextRule struct {
+	count  int
+	cancel context.CancelFunc
+}
+
+func (r *contextRule) Validate(validator *valis.Validator, value interface{}) {
+	r.count++
+	if validator.Context().Value(ctxKey{}) == nil {
+		validator.ErrorCollector().Add(validator.Location(), valis.NewError(code.Invalid, value))
+	}
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+func TestValidator_ValidateContext(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	assert.NoError(valis.NewValidator().ValidateContext(ctx, "a", &contextRule{}))
+	assert.EqualError(valis.NewValidator().Validate("a", &contextRule{}), "(invalid) is invalid")
+
+	// NOTE: it stops descending when the context is done.
+	ctx, cancel := context.WithCancel(ctx)
+	rule := &contextRule{cancel: cancel}
+	assert.EqualError(
+		valis.NewValidator().ValidateContext(ctx, []string{"a", "b", "c"}, valis.Each(rule)),
+		"(canceled) validation was canceled (context canceled)",
+	)
+	assert.Equal(1, rule.count)
+
+	type User struct {
+		Name string
+		Age  int
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	rule = &contextRule{}
+	assert.EqualError(
+		valis.ValidateContext(ctx, &User{}, valis.EachFields(rule)),
+		"(canceled) validation was canceled (context canceled)",
+	)
+	assert.Equal(0, rule.count)
+
+	// NOTE: it succeeds when the context is done after all rules are performed.
+	ctx, cancel = context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+	rule = &contextRule{cancel: cancel}
+	assert.NoError(
+		valis.NewValidator().ValidateContext(ctx, []string{"a"}, valis.Each(rule)),
+	)
+	assert.Equal(1, rule.count)
+}
+
+func TestValidator_SetMaxErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	v := valis.NewValidator()
+	assert.EqualError(
+		v.Validate([]string{"", "", ""}, valis.Each(is.NonZero)),
+		"(non_zero) [0] can't be blank (or zero)\n"+
+			"(non_zero) [1] can't be blank (or zero)\n"+
+			"(non_zero) [2] can't be blank (or zero)",
+	)
+
+	v.SetMaxErrors(1)
+	assert.EqualError(
+		v.Validate([]string{"", "", ""}, valis.Each(is.NonZero)),
+		"(non_zero) [0] can't be blank (or zero)",
+	)
+	assert.EqualError(
+		v.Validate("", is.NonZero, is.In("a")),
+		"(non_zero) can't be blank (or zero)",
+	)
+
+	// NOTE: the rules stop traversal when the ErrorCollector is full.
+	rule := &contextRule{}
+	assert.EqualError(
+		v.Validate(map[string]string{"a": ""}, valis.EachValues(is.NonZero), valis.EachKeys(rule), valis.Or(rule)),
+		"(non_zero) [a] can't be blank (or zero)",
+	)
+	assert.Equal(0, rule.count)
+
+	type User struct {
+		Name string
+		Age  int
+	}
+	v.SetMaxErrors(2)
+	assert.EqualError(
+		v.Validate(&User{}, valis.EachFields(is.NonZero), valis.EachFields(is.NonZero)),
+		"(non_zero) .Name can't be blank (or zero)\n(non_zero) .Age can't be blank (or zero)",
+	)
+
+	// NOTE: zero means unlimited.
+	v.SetMaxErrors(0)
+	assert.EqualError(
+		v.Validate("", is.NonZero, is.In("a")),
+		"(non_zero) can't be blank (or zero)\n(inclusion) is not included in [a]",
+	)
+}
+
+type parentRule struct {
+	parents []interface{}
+	roots   []interface{}
+}
+
+func (r *parentRule) Validate(validator *valis.Validator, value interface{}) {
+	r.parents = append(r.parents, validator.Parent())
+	r.roots = append(r.roots, validator.Root())
+}
+
+func TestValidator_SetMaxDepth(t *testing.T) {
+	assert := assert.New(t)
+
+	type Node struct {
+		Name     string
+		Children []*Node
+	}
+
+	v := valis.NewValidator()
+	v.SetCommonRules(
+		when.IsStruct(valis.EachFields()).
+			ElseWhen(when.IsSliceOrArray(valis.Each())),
+	)
+	n := &Node{Children: []*Node{{Children: []*Node{{}}}}}
+	assert.NoError(v.Validate(n))
+
+	v.SetMaxDepth(3)
+	assert.EqualError(
+		v.Validate(n),
+		"(max_depth) .Children[0].Children[0] exceeds the maximum depth (3)",
+	)
+
+	deep := valis.NewValidator()
+	deep.SetMaxDepth(3)
+	assert.EqualError(
+		deep.Validate(n, valis.Deep()),
+		"(max_depth) .Children[0].Children[0] exceeds the maximum depth (3)",
+	)
+
+	// NOTE: the depth is kept through To.
+	identity := func(value interface{}) (interface{}, error) {
+		return value, nil
+	}
+	through := valis.NewValidator()
+	through.SetMaxDepth(2)
+	assert.EqualError(
+		through.Validate(n, valis.Field(&n.Children, valis.Each(valis.To(identity, valis.EachFields(is.Any))))),
+		"(max_depth) .Children[0].Name exceeds the maximum depth (2)\n"+
+			"(max_depth) .Children[0].Children exceeds the maximum depth (2)",
+	)
+
+	v.SetMaxDepth(0)
+	assert.NoError(v.Validate(n))
+}
+
+type linkedNode struct {
+	Next *linkedNode
+}
+
+// nextRule validates the values following the Next field, using DiveField.
+type nextRule struct{}
+
+func (r *nextRule) Validate(validator *valis.Validator, value interface{}) {
+	node := value.(*linkedNode)
+	if node.Next == nil {
+		return
+	}
+	field, _ := reflect.TypeOf(linkedNode{}).FieldByName("Next")
+	validator.DiveField(&field, func(v *valis.Validator) {
+		valis.And(r).Validate(v, node.Next)
+	})
+}
+
+func TestValidator_circularReference(t *testing.T) {
+	assert := assert.New(t)
+
+	type Node struct {
+		Name     string `json:"name"`
+		Parent   *Node  `json:"parent"`
+		Children []*Node
+		Values   map[string]interface{}
+	}
+
+	v := valis.NewValidator()
+	v.SetCommonRules(
+		when.IsStruct(valis.EachFields()).
+			ElseWhen(when.IsSliceOrArray(valis.Each())).
+			ElseWhen(when.IsMap(valis.EachValues())),
+	)
+
+	root := &Node{Name: "root", Values: map[string]interface{}{}}
+	child := &Node{Name: "child", Parent: root}
+	root.Children = []*Node{child}
+	root.Values["self"] = root.Values
+
+	assert.EqualError(
+		v.Validate(root),
+		"(circular_reference) .Children[0].Parent is a circular reference\n"+
+			"(circular_reference) .Values[self] is a circular reference",
+	)
+
+	// the same pointer in different locations is not a circular reference
+	shared := &Node{Name: "shared"}
+	assert.NoError(v.Validate(&Node{Children: []*Node{shared, shared}}))
+
+	// the workers of ParallelEach also detect the references to the values of the ancestors
+	pv := valis.NewValidator()
+	pv.SetConcurrency(2)
+	pv.SetCommonRules(
+		when.IsStruct(valis.EachFields()).
+			ElseWhen(when.IsSliceOrArray(valis.ParallelEach())),
+	)
+	parent := &Node{Name: "parent"}
+	parent.Children = []*Node{{Parent: parent}, shared, shared, {Parent: parent}}
+	assert.EqualError(
+		pv.Validate(parent),
+		"(circular_reference) .Children[0].Parent is a circular reference\n"+
+			"(circular_reference) .Children[3].Parent is a circular reference",
+	)
+
+	// the values given to the rules after DiveField are also checked
+	a := &linkedNode{}
+	a.Next = &linkedNode{Next: a}
+	assert.EqualError(
+		valis.Validate(a, &nextRule{}),
+		"(circular_reference) .Next.Next is a circular reference",
+	)
+}
+
+func TestValidator_circularReferenceExplicitRules(t *testing.T) {
+	assert := assert.New(t)
+
+	type Node struct {
+		Name     string
+		Parent   *Node `required:"true"`
+		Children []*Node
+	}
+
+	// NOTE: the back-pointer is not a circular reference, when the rules do not descend from it.
+	root := &Node{Name: "root"}
+	root.Children = []*Node{{Name: "child", Parent: root}}
+	assert.NoError(valis.Validate(root, valis.Field(&root.Children, valis.Each(valis.EachFields(tagrule.Required)))))
+	root.Children[0].Parent = nil
+	assert.EqualError(
+		valis.Validate(root, valis.Field(&root.Children, valis.Each(valis.EachFields(tagrule.Required)))),
+		"(required) .Children[0].Parent is required",
+	)
+
+	// NOTE: the rules of the circular reference are performed, even if it is reported.
+	root.Children[0] = &Node{Parent: root}
+	assert.EqualError(
+		valis.Validate(root, valis.Field(&root.Children, valis.Each(valis.EachFields(is.Zero, when.IsStruct(valis.EachFields(is.Zero)))))),
+		"(zero_only) .Children[0].Parent must be blank\n"+
+			"(circular_reference) .Children[0].Parent is a circular reference",
+	)
+}
+
+func TestValidator_circularReferenceTo(t *testing.T) {
+	assert := assert.New(t)
+
+	type S struct {
+		Name string `required:"true"`
+	}
+	identity := func(value interface{}) (interface{}, error) {
+		return value, nil
+	}
+
+	// NOTE: the converted value is not an ancestor of itself.
+	s := &S{Name: "a"}
+	assert.NoError(valis.Validate(s, valis.To(identity, is.Required)))
+	assert.NoError(valis.Validate(s, valis.EachFields(tagrule.Required), valis.To(identity, valis.EachFields(tagrule.Required))))
+
+	// NOTE: the converted value is left after the rules of To.
+	type T struct {
+		A *S
+		B *S
+	}
+	tv := &T{A: s, B: s}
+	assert.NoError(valis.Validate(tv, valis.EachFields(valis.To(identity, valis.EachFields(tagrule.Required)))))
+	assert.NoError(valis.Validate(
+		tv,
+		valis.Field(&tv.A, valis.To(func(value interface{}) (interface{}, error) {
+			return tv.B, nil
+		}, valis.EachFields())),
+		valis.Field(&tv.B, valis.EachFields()),
+	))
+}
+
+func TestValidator_Parent(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name string
+		Tags []string
+	}
+
+	u := &User{Name: "alice", Tags: []string{"a"}}
+	r := &parentRule{}
+	assert.NoError(valis.NewValidator().Validate(
+		u,
+		r,
+		valis.Field(&u.Name, r, to.String(r)),
+		valis.Field(&u.Tags, valis.Each(r)),
+		valis.EachFields(r),
+	))
+	assert.Equal([]interface{}{nil, u, u, u.Tags, u, u}, r.parents)
+	assert.Equal([]interface{}{u, u, u, u, u, u}, r.roots)
+}
+
+func TestValidator_ValidateWithWarnings(t *testing.T) {
+	assert := assert.New(t)
+
+	type User struct {
+		Name     string
+		Nickname string
+	}
+	v := valis.NewValidator()
+
+	// NOTE: it returns only the warnings, when there are no errors.
+	user := User{Name: "taro", Nickname: "taro"}
+	warnings, err := v.ValidateWithWarnings(&user, valis.Field(&user.Name, is.NonZero), valis.Field(&user.Nickname, is.Deprecated))
+	assert.NoError(err)
+	assert.EqualError(warnings, "(deprecated) [warning] .Nickname is deprecated")
+	assert.NoError(v.Validate(&user, valis.Field(&user.Name, is.NonZero), valis.Field(&user.Nickname, is.Deprecated)))
+
+	// NOTE: the err includes the warnings, when there are errors.
+	user = User{Nickname: "taro"}
+	warnings, err = v.ValidateWithWarnings(&user, valis.Field(&user.Name, is.NonZero), valis.Field(&user.Nickname, is.Deprecated))
+	assert.EqualError(warnings, "(deprecated) [warning] .Nickname is deprecated")
+	assert.EqualError(err, "(non_zero) .Name can't be blank (or zero)\n(deprecated) [warning] .Nickname is deprecated")
+
+	warnings, err = v.ValidateWithWarnings(&User{})
+	assert.NoError(warnings)
+	assert.NoError(err)
+
+	// NOTE: the warnings are not counted as the errors.
+	v.SetMaxErrors(1)
+	user = User{Nickname: "taro"}
+	warnings, err = v.ValidateWithWarnings(&user, valis.Field(&user.Nickname, is.Deprecated), valis.Field(&user.Name, is.NonZero))
+	assert.EqualError(warnings, "(deprecated) [warning] .Nickname is deprecated")
+	assert.EqualError(err, "(deprecated) [warning] .Nickname is deprecated\n(non_zero) .Name can't be blank (or zero)")
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/soranoba/valis"
+	"github.com/soranoba/valis/is"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarn(t *testing.T) {
+	assert := assert.New(t)
+
+	type Usage struct {
+		Requests int
+	}
+	usage := Usage{Requests: 95}
+
+	warnings, err := valis.ValidateWithWarnings(&usage, valis.Field(&usage.Requests, is.LessThanOrEqualTo(100), valis.Warn(is.LessThan(90))))
+	assert.NoError(err)
+	assert.EqualError(warnings, "(lt) [warning] .Requests must be less than 90")
+
+	usage = Usage{Requests: 101}
+	warnings, err = valis.ValidateWithWarnings(&usage, valis.Field(&usage.Requests, is.LessThanOrEqualTo(100), valis.Warn(is.LessThan(90))))
+	assert.EqualError(err, "(lte) .Requests must be less than or equal to 100\n(lt) [warning] .Requests must be less than 90")
+	assert.EqualError(warnings, "(lt) [warning] .Requests must be less than 90")
+
+	// NOTE: the warnings of the rule that is met are kept in Or.
+	warnings, err = valis.ValidateWithWarnings(95, valis.Or(is.LessThan(90), valis.Warn(is.LessThan(90))))
+	assert.NoError(err)
+	assert.EqualError(warnings, "(lt) [warning] must be less than 90")
+}
@@ -124,6 +124,26 @@ func TestIsNumeric(t *testing.T) {
 	)
 }
 
+func TestIsString(t *testing.T) {
+	assert := assert.New(t)
+
+	s := "aa"
+	assert.NoError(
+		v.Validate(1, when.IsString(is.Zero)),
+	)
+	assert.NoError(
+		v.Validate((*string)(nil), when.IsString(is.Zero)),
+	)
+	assert.EqualError(
+		v.Validate("aa", when.IsString(is.Zero)),
+		"(zero_only) must be blank",
+	)
+	assert.EqualError(
+		v.Validate(&s, when.IsString(is.Zero)),
+		"(zero_only) must be blank",
+	)
+}
+
 func TestIsNil(t *testing.T) {
 	assert := assert.New(t)
 
@@ -32,7 +32,14 @@ func (rule *toRule) Validate(validator *Validator, value interface{}) {
 
 	errorCollector := newToRuleErrorCollector(validator.ErrorCollector(), validator.Location(), value)
 	newValidator := validator.Clone(&CloneOpts{InheritLocation: true, ErrorCollector: errorCollector})
+	// NOTE: the converted value is saved to a new node, so that it does not overwrite the value before conversion.
+	newValidator.node = &valueNode{origin: validator.node}
+	if validator.node != nil {
+		newValidator.node.parent = validator.node.parent
+		newValidator.node.depth = validator.node.depth
+	}
 	And(rule.rules...).Validate(newValidator, newValue)
+	newValidator.leave(newValidator.node)
 }
 
 func newToRuleErrorCollector(errorCollector ErrorCollector, location *Location, value interface{}) ErrorCollector {
@@ -47,3 +54,10 @@ func (c *toRuleErrorCollector) Add(loc *Location, err Error) {
 	}
 	c.ErrorCollector.Add(loc, err)
 }
+
+func (c *toRuleErrorCollector) IsFull() bool {
+	if errorCollector, ok := c.ErrorCollector.(LimitedErrorCollector); ok {
+		return errorCollector.IsFull()
+	}
+	return false
+}
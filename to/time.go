@@ -0,0 +1,53 @@
+package to
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/soranoba/valis"
+)
+
+const (
+	// DateLayout is a layout of the date without time (e.g. 2006-01-02).
+	DateLayout = "2006-01-02"
+)
+
+var (
+	// Time is a valis.CombinationRule that validates after the value convert to time.Time.
+	// The string is parsed as RFC3339 or DateLayout. See also TimeWithLayouts.
+	Time = TimeWithLayouts(time.RFC3339, DateLayout)
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// TimeWithLayouts returns a valis.CombinationRule that validates after the value convert to time.Time.
+// The string is parsed with the layouts in order, and the time.Time is used as it is.
+func TimeWithLayouts(layouts ...string) valis.CombinationRule {
+	return NewCombinationRule(func(value interface{}) (interface{}, error) {
+		val := reflect.ValueOf(value)
+		for val.Kind() == reflect.Ptr {
+			val = val.Elem()
+		}
+		if !val.IsValid() || !val.CanInterface() {
+			return nil, errors.New("can not convert to time.Time")
+		}
+
+		if val.Type() == timeType {
+			return val.Interface(), nil
+		}
+		if val.Kind() != reflect.String {
+			return nil, fmt.Errorf("can not convert from %s to time.Time", val.Type().String())
+		}
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, val.String()); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("can not parse as time.Time (%s)", strings.Join(layouts, ", "))
+	})
+}
@@ -0,0 +1,97 @@
+package valis
+
+import (
+	"reflect"
+
+	"github.com/soranoba/valis/code"
+)
+
+type (
+	transformRule struct {
+		transformFunc ConvertFunc
+	}
+)
+
+// Transform returns a new rule that writes the transformed value back to the current location.
+// Unlike To, the subsequent rules verify the transformed value, and the original value is overwritten.
+//
+// The value must be addressable. In other words, it has to be reached via a pointer (e.g. Validate(&user, ...)),
+// a slice or a map. Otherwise, the rule adds the error of code.NotAssignable.
+func Transform(transformFunc ConvertFunc) Rule {
+	return &transformRule{transformFunc: transformFunc}
+}
+
+func (rule *transformRule) Validate(validator *Validator, value interface{}) {
+	newValue, err := rule.transformFunc(value)
+	if err != nil {
+		validator.ErrorCollector().Add(validator.Location(), NewError(code.ConversionFailed, value, err))
+		return
+	}
+	if !validator.assign(newValue) {
+		validator.ErrorCollector().Add(validator.Location(), NewError(code.NotAssignable, value, typeName(value)))
+	}
+}
+
+// assign writes the value to the current location, and saves it as the validating value.
+// It returns false, when the location is not addressable or the type of value is not assignable.
+func (v *Validator) assign(value interface{}) bool {
+	newVal := reflect.ValueOf(value)
+	node := v.node
+	if !newVal.IsValid() || node == nil {
+		return false
+	}
+
+	switch {
+	case node.key.IsValid():
+		elem := reflect.New(node.ref.Type().Elem()).Elem()
+		elem.Set(node.refValue())
+		if !assignValue(elem, newVal) {
+			return false
+		}
+		if node.mapLock != nil {
+			node.mapLock.Lock()
+			defer node.mapLock.Unlock()
+		}
+		node.ref.SetMapIndex(node.key, elem)
+		node.value = elem.Interface()
+	case node.ref.IsValid():
+		if !assignValue(node.ref, newVal) {
+			return false
+		}
+		node.value = node.ref.Interface()
+	default:
+		// NOTE: the value of the root location can be written, when it is a pointer.
+		if !assignValue(reflect.ValueOf(node.value), newVal) {
+			return false
+		}
+	}
+	return true
+}
+
+// assignValue sets the src to the dst or the element of the dst pointer.
+func assignValue(dst reflect.Value, src reflect.Value) bool {
+	for dst.IsValid() {
+		if dst.CanSet() {
+			if src.Type().AssignableTo(dst.Type()) {
+				dst.Set(src)
+				return true
+			}
+			if src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()) {
+				dst.Set(src.Convert(dst.Type()))
+				return true
+			}
+		}
+		if dst.Kind() != reflect.Ptr || dst.IsNil() {
+			return false
+		}
+		dst = dst.Elem()
+	}
+	return false
+}
+
+func typeName(value interface{}) string {
+	if t := reflect.TypeOf(value); t != nil {
+		return t.String()
+	}
+	return "nil"
+}
@@ -0,0 +1,132 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultGerman is a CatalogRegistrationFunc for German.
+func DefaultGerman(c *catalog.Builder) {
+	tag := language.German
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("muss eine Zeichenkette sein"))
+	c.Set(tag, code.NotStruct, catalog.String("muss eine Struktur sein"))
+	c.Set(tag, code.NotStructField, catalog.String("muss ein Strukturfeld sein"))
+	c.Set(tag, code.NotArray, catalog.String("muss ein Array sein"))
+	c.Set(tag, code.NotMap, catalog.String("muss eine Map sein"))
+	c.Set(tag, code.NotNumeric, catalog.String("muss eine Zahl sein"))
+	c.Set(tag, code.NotInteger, catalog.String("muss eine ganze Zahl sein"))
+	c.Set(tag, code.NotBoolean, catalog.String("muss ein boolescher Wert sein"))
+	c.Set(tag, code.NotTime, catalog.String("muss eine Zeitangabe sein"))
+	c.Set(tag, code.NotDuration, catalog.String("muss eine Zeitdauer sein"))
+	c.Set(tag, code.NotNull, catalog.String("muss null sein"))
+	c.Set(tag, code.NotIterable, catalog.String("muss ein iterierbarer Wert sein"))
+	c.Set(tag, code.NotAssignable, catalog.String("kann nicht %[1]s zugewiesen werden"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("benötigt einen Wert für den Schlüssel (%[1]v)"))
+	c.Set(tag, code.NoField, catalog.String("verweist auf das unbekannte Feld (%[1]s)"))
+	c.Set(tag, code.OutOfRange, catalog.String("benötigt mehr als %[1]d Elemente"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("die Validierung wurde abgebrochen (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("überschreitet die maximale Tiefe (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("ist ein Zirkelbezug"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("ist ungültig"))
+	c.Set(tag, code.Required, catalog.String("ist erforderlich"))
+	c.Set(tag, code.NonZero, catalog.String("darf nicht leer (oder null) sein"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("darf nicht leer (oder null) sein, wenn angegeben"))
+	c.Set(tag, code.ZeroOnly, catalog.String("muss leer sein"))
+
+	c.Set(tag, code.TooLongLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "Zeichen", plural.Other, "Zeichen")),
+		catalog.String("ist zu lang (maximal %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "Zeichen", plural.Other, "Zeichen")),
+		catalog.String("ist zu kurz (mindestens %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooLongLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "Element", plural.Other, "Elemente")),
+		catalog.String("hat zu viele Elemente (maximal %[1]d ${elements})"),
+	)
+	c.Set(tag, code.TooShortLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "Element", plural.Other, "Elemente")),
+		catalog.String("hat zu wenige Elemente (mindestens %[1]d ${elements})"),
+	)
+	c.Set(tag, code.GreaterThan, catalog.String("muss größer als %[1]v sein"))
+	c.Set(tag, code.LessThan, catalog.String("muss kleiner als %[1]v sein"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("muss größer oder gleich %[1]v sein"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("muss kleiner oder gleich %[1]v sein"))
+
+	c.Set(tag, code.Inclusion, catalog.String("ist nicht in %[1]v enthalten"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("passt nicht zum regulären Ausdruck (%[1]s)"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("ist keine gültige URL"))
+	c.Set(tag, code.InvalidScheme, catalog.String("hat ein Schema, das nicht in %[1]v enthalten ist"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("ist keine gültige E-Mail-Adresse"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("ist keine gültige UUID"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("ist keine gültige IP-Adresse"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("ist keine gültige IPv4-Adresse"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("ist keine gültige IPv6-Adresse"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("ist keine gültige CIDR-Notation"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("ist keine gültige MAC-Adresse"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("ist kein gültiger Hostname"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("ist kein gültiger vollqualifizierter Domainname"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("ist keine gültige semantische Version"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("ist keine gültige Base64-Zeichenkette"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("ist kein gültiger Hex-Farbcode"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("ist kein gültiger Ländercode"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("ist kein gültiger Währungscode"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("ist kein gültiges Sprach-Tag"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("darf nur ASCII-Zeichen enthalten"))
+	c.Set(tag, code.PrintableOnly, catalog.String("darf nur druckbare Zeichen enthalten"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("darf nur Buchstaben und Ziffern enthalten"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("darf keine Steuerzeichen enthalten"))
+	c.Set(tag, code.NotNormalized, catalog.String("muss in %[1]s normalisiert sein"))
+	c.Set(tag, code.TooLongGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "Zeichen", plural.Other, "Zeichen")),
+		catalog.String("ist zu lang (maximal %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "Zeichen", plural.Other, "Zeichen")),
+		catalog.String("ist zu kurz (mindestens %[1]d ${characters})"),
+	)
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("muss gleich %[1]s sein"))
+	c.Set(tag, code.NotEqualField, catalog.String("darf nicht gleich %[1]s sein"))
+	c.Set(tag, code.GreaterThanField, catalog.String("muss größer als %[1]s sein"))
+	c.Set(tag, code.LessThanField, catalog.String("muss kleiner als %[1]s sein"))
+	c.Set(tag, code.RequiredIf, catalog.String("ist erforderlich, wenn %[1]s gleich %[2]s ist"))
+	c.Set(tag, code.RequiredWith, catalog.String("ist erforderlich, wenn %[1]s angegeben ist"))
+	c.Set(tag, code.RequiredWithout, catalog.String("ist erforderlich, wenn %[1]s nicht angegeben ist"))
+	c.Set(tag, code.ExcludedIf, catalog.String("muss leer sein, wenn %[1]s gleich %[2]s ist"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("muss vor %[1]v liegen"))
+	c.Set(tag, code.After, catalog.String("muss nach %[1]v liegen"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("muss zwischen %[1]v und %[2]v liegen"))
+	c.Set(tag, code.Future, catalog.String("muss in der Zukunft liegen"))
+	c.Set(tag, code.Past, catalog.String("muss in der Vergangenheit liegen"))
+	c.Set(tag, code.Weekday, catalog.String("muss einer der Wochentage %[1]v sein"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("muss zwischen %[1]v und %[2]v liegen"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("ist veraltet"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s %[2]s"))
+}
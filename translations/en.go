@@ -17,15 +17,28 @@ func DefaultEnglish(c *catalog.Builder) {
 	c.Set(tag, code.NotStructField, catalog.String("must be any struct field"))
 	c.Set(tag, code.NotArray, catalog.String("must be any array"))
 	c.Set(tag, code.NotMap, catalog.String("must be any map"))
+	c.Set(tag, code.NotNumeric, catalog.String("must be any number"))
+	c.Set(tag, code.NotInteger, catalog.String("must be any integer"))
+	c.Set(tag, code.NotBoolean, catalog.String("must be any boolean"))
+	c.Set(tag, code.NotTime, catalog.String("must be any time"))
+	c.Set(tag, code.NotDuration, catalog.String("must be any duration"))
+	c.Set(tag, code.NotNull, catalog.String("must be null"))
+	c.Set(tag, code.NotIterable, catalog.String("must be any iterable value"))
 	c.Set(tag, code.NotAssignable, catalog.String("can't assign to %[1]s"))
 
 	// not found error
 	c.Set(tag, code.NoKey, catalog.String("requires the value at the key (%[1]v)"))
+	c.Set(tag, code.NoField, catalog.String("refers to the unknown field (%[1]s)"))
 	c.Set(tag, code.OutOfRange, catalog.String("requires more than %[1]d elements"))
 
 	// convert error
 	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
 
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("validation was canceled (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("exceeds the maximum depth (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("is a circular reference"))
+
 	// others
 	c.Set(tag, code.Custom, catalog.String("%[1]v"))
 	c.Set(tag, code.Invalid, catalog.String("is invalid"))
@@ -60,4 +73,60 @@ func DefaultEnglish(c *catalog.Builder) {
 	c.Set(tag, code.InvalidURLFormat, catalog.String("is an invalid url format"))
 	c.Set(tag, code.InvalidScheme, catalog.String("which scheme is not included in %[1]v"))
 	c.Set(tag, code.InvalidEmailFormat, catalog.String("is an invalid email address"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("is an invalid UUID"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("is an invalid IP address"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("is an invalid IPv4 address"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("is an invalid IPv6 address"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("is an invalid CIDR notation"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("is an invalid MAC address"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("is an invalid hostname"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("is an invalid fully qualified domain name"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("is an invalid semantic version"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("is an invalid base64 string"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("is an invalid hex color code"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("is an invalid country code"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("is an invalid currency code"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("is an invalid language tag"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("must contain only ASCII characters"))
+	c.Set(tag, code.PrintableOnly, catalog.String("must contain only printable characters"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("must contain only letters and digits"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("must not contain control characters"))
+	c.Set(tag, code.NotNormalized, catalog.String("must be normalized in %[1]s"))
+	c.Set(tag, code.TooLongGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "character", plural.Other, "characters")),
+		catalog.String("is too long length (maximum is %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "character", plural.Other, "characters")),
+		catalog.String("is too short length (minimum is %[1]d ${characters})"),
+	)
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("must be equal to %[1]s"))
+	c.Set(tag, code.NotEqualField, catalog.String("must not be equal to %[1]s"))
+	c.Set(tag, code.GreaterThanField, catalog.String("must be greater than %[1]s"))
+	c.Set(tag, code.LessThanField, catalog.String("must be less than %[1]s"))
+	c.Set(tag, code.RequiredIf, catalog.String("is required when %[1]s is %[2]s"))
+	c.Set(tag, code.RequiredWith, catalog.String("is required when %[1]s is present"))
+	c.Set(tag, code.RequiredWithout, catalog.String("is required when %[1]s is not present"))
+	c.Set(tag, code.ExcludedIf, catalog.String("must be blank when %[1]s is %[2]s"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("must be before %[1]v"))
+	c.Set(tag, code.After, catalog.String("must be after %[1]v"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("must be between %[1]v and %[2]v"))
+	c.Set(tag, code.Future, catalog.String("must be in the future"))
+	c.Set(tag, code.Past, catalog.String("must be in the past"))
+	c.Set(tag, code.Weekday, catalog.String("must be one of the weekdays %[1]v"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("must be between %[1]v and %[2]v"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("is deprecated"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s %[2]s"))
 }
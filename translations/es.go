@@ -0,0 +1,132 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultSpanish is a CatalogRegistrationFunc for Spanish.
+func DefaultSpanish(c *catalog.Builder) {
+	tag := language.Spanish
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("debe ser una cadena"))
+	c.Set(tag, code.NotStruct, catalog.String("debe ser una estructura"))
+	c.Set(tag, code.NotStructField, catalog.String("debe ser un campo de estructura"))
+	c.Set(tag, code.NotArray, catalog.String("debe ser un arreglo"))
+	c.Set(tag, code.NotMap, catalog.String("debe ser un mapa"))
+	c.Set(tag, code.NotNumeric, catalog.String("debe ser un número"))
+	c.Set(tag, code.NotInteger, catalog.String("debe ser un número entero"))
+	c.Set(tag, code.NotBoolean, catalog.String("debe ser un booleano"))
+	c.Set(tag, code.NotTime, catalog.String("debe ser una fecha"))
+	c.Set(tag, code.NotDuration, catalog.String("debe ser una duración"))
+	c.Set(tag, code.NotNull, catalog.String("debe ser nulo"))
+	c.Set(tag, code.NotIterable, catalog.String("debe ser un valor iterable"))
+	c.Set(tag, code.NotAssignable, catalog.String("no se puede asignar a %[1]s"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("requiere el valor de la clave (%[1]v)"))
+	c.Set(tag, code.NoField, catalog.String("hace referencia al campo desconocido (%[1]s)"))
+	c.Set(tag, code.OutOfRange, catalog.String("requiere más de %[1]d elementos"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("la validación fue cancelada (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("excede la profundidad máxima (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("es una referencia circular"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("no es válido"))
+	c.Set(tag, code.Required, catalog.String("es obligatorio"))
+	c.Set(tag, code.NonZero, catalog.String("no puede estar vacío (o ser cero)"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("no puede estar vacío (o ser cero) si se especifica"))
+	c.Set(tag, code.ZeroOnly, catalog.String("debe estar vacío"))
+
+	c.Set(tag, code.TooLongLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "carácter", plural.Other, "caracteres")),
+		catalog.String("es demasiado largo (máximo %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "carácter", plural.Other, "caracteres")),
+		catalog.String("es demasiado corto (mínimo %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooLongLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "elemento", plural.Other, "elementos")),
+		catalog.String("tiene demasiados elementos (máximo %[1]d ${elements})"),
+	)
+	c.Set(tag, code.TooShortLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "elemento", plural.Other, "elementos")),
+		catalog.String("tiene muy pocos elementos (mínimo %[1]d ${elements})"),
+	)
+	c.Set(tag, code.GreaterThan, catalog.String("debe ser mayor que %[1]v"))
+	c.Set(tag, code.LessThan, catalog.String("debe ser menor que %[1]v"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("debe ser mayor o igual que %[1]v"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("debe ser menor o igual que %[1]v"))
+
+	c.Set(tag, code.Inclusion, catalog.String("no está incluido en %[1]v"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("no coincide con la expresión regular (%[1]s)"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("no es una URL válida"))
+	c.Set(tag, code.InvalidScheme, catalog.String("tiene un esquema que no está incluido en %[1]v"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("no es una dirección de correo electrónico válida"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("no es un UUID válido"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("no es una dirección IP válida"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("no es una dirección IPv4 válida"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("no es una dirección IPv6 válida"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("no es una notación CIDR válida"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("no es una dirección MAC válida"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("no es un nombre de host válido"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("no es un nombre de dominio completo válido"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("no es una versión semántica válida"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("no es una cadena base64 válida"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("no es un código de color hexadecimal válido"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("no es un código de país válido"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("no es un código de moneda válido"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("no es una etiqueta de idioma válida"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("solo puede contener caracteres ASCII"))
+	c.Set(tag, code.PrintableOnly, catalog.String("solo puede contener caracteres imprimibles"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("solo puede contener letras y dígitos"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("no puede contener caracteres de control"))
+	c.Set(tag, code.NotNormalized, catalog.String("debe estar normalizado en %[1]s"))
+	c.Set(tag, code.TooLongGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "carácter", plural.Other, "caracteres")),
+		catalog.String("es demasiado largo (máximo %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "carácter", plural.Other, "caracteres")),
+		catalog.String("es demasiado corto (mínimo %[1]d ${characters})"),
+	)
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("debe ser igual a %[1]s"))
+	c.Set(tag, code.NotEqualField, catalog.String("no debe ser igual a %[1]s"))
+	c.Set(tag, code.GreaterThanField, catalog.String("debe ser mayor que %[1]s"))
+	c.Set(tag, code.LessThanField, catalog.String("debe ser menor que %[1]s"))
+	c.Set(tag, code.RequiredIf, catalog.String("es obligatorio cuando %[1]s es %[2]s"))
+	c.Set(tag, code.RequiredWith, catalog.String("es obligatorio cuando %[1]s está presente"))
+	c.Set(tag, code.RequiredWithout, catalog.String("es obligatorio cuando %[1]s no está presente"))
+	c.Set(tag, code.ExcludedIf, catalog.String("debe estar vacío cuando %[1]s es %[2]s"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("debe ser anterior a %[1]v"))
+	c.Set(tag, code.After, catalog.String("debe ser posterior a %[1]v"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("debe estar entre %[1]v y %[2]v"))
+	c.Set(tag, code.Future, catalog.String("debe estar en el futuro"))
+	c.Set(tag, code.Past, catalog.String("debe estar en el pasado"))
+	c.Set(tag, code.Weekday, catalog.String("debe ser uno de los días de la semana %[1]v"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("debe estar entre %[1]v y %[2]v"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("está obsoleto"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s %[2]s"))
+}
@@ -0,0 +1,132 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultFrench is a CatalogRegistrationFunc for French.
+func DefaultFrench(c *catalog.Builder) {
+	tag := language.French
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("doit être une chaîne de caractères"))
+	c.Set(tag, code.NotStruct, catalog.String("doit être une structure"))
+	c.Set(tag, code.NotStructField, catalog.String("doit être un champ de structure"))
+	c.Set(tag, code.NotArray, catalog.String("doit être un tableau"))
+	c.Set(tag, code.NotMap, catalog.String("doit être une map"))
+	c.Set(tag, code.NotNumeric, catalog.String("doit être un nombre"))
+	c.Set(tag, code.NotInteger, catalog.String("doit être un entier"))
+	c.Set(tag, code.NotBoolean, catalog.String("doit être un booléen"))
+	c.Set(tag, code.NotTime, catalog.String("doit être une date"))
+	c.Set(tag, code.NotDuration, catalog.String("doit être une durée"))
+	c.Set(tag, code.NotNull, catalog.String("doit être null"))
+	c.Set(tag, code.NotIterable, catalog.String("doit être une valeur itérable"))
+	c.Set(tag, code.NotAssignable, catalog.String("ne peut pas être affecté à %[1]s"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("nécessite une valeur pour la clé (%[1]v)"))
+	c.Set(tag, code.NoField, catalog.String("fait référence au champ inconnu (%[1]s)"))
+	c.Set(tag, code.OutOfRange, catalog.String("nécessite plus de %[1]d éléments"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("la validation a été annulée (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("dépasse la profondeur maximale (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("est une référence circulaire"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("n'est pas valide"))
+	c.Set(tag, code.Required, catalog.String("est obligatoire"))
+	c.Set(tag, code.NonZero, catalog.String("ne peut pas être vide (ou zéro)"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("ne peut pas être vide (ou zéro) s'il est spécifié"))
+	c.Set(tag, code.ZeroOnly, catalog.String("doit être vide"))
+
+	c.Set(tag, code.TooLongLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractère", plural.Other, "caractères")),
+		catalog.String("est trop long (maximum %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractère", plural.Other, "caractères")),
+		catalog.String("est trop court (minimum %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooLongLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "élément", plural.Other, "éléments")),
+		catalog.String("contient trop d'éléments (maximum %[1]d ${elements})"),
+	)
+	c.Set(tag, code.TooShortLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "élément", plural.Other, "éléments")),
+		catalog.String("contient trop peu d'éléments (minimum %[1]d ${elements})"),
+	)
+	c.Set(tag, code.GreaterThan, catalog.String("doit être supérieur à %[1]v"))
+	c.Set(tag, code.LessThan, catalog.String("doit être inférieur à %[1]v"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("doit être supérieur ou égal à %[1]v"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("doit être inférieur ou égal à %[1]v"))
+
+	c.Set(tag, code.Inclusion, catalog.String("n'est pas inclus dans %[1]v"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("ne correspond pas à l'expression régulière (%[1]s)"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("n'est pas une URL valide"))
+	c.Set(tag, code.InvalidScheme, catalog.String("a un schéma qui n'est pas inclus dans %[1]v"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("n'est pas une adresse e-mail valide"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("n'est pas un UUID valide"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("n'est pas une adresse IP valide"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("n'est pas une adresse IPv4 valide"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("n'est pas une adresse IPv6 valide"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("n'est pas une notation CIDR valide"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("n'est pas une adresse MAC valide"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("n'est pas un nom d'hôte valide"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("n'est pas un nom de domaine complet valide"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("n'est pas une version sémantique valide"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("n'est pas une chaîne base64 valide"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("n'est pas un code couleur hexadécimal valide"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("n'est pas un code pays valide"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("n'est pas un code devise valide"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("n'est pas une balise de langue valide"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("ne doit contenir que des caractères ASCII"))
+	c.Set(tag, code.PrintableOnly, catalog.String("ne doit contenir que des caractères imprimables"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("ne doit contenir que des lettres et des chiffres"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("ne doit pas contenir de caractères de contrôle"))
+	c.Set(tag, code.NotNormalized, catalog.String("doit être normalisé en %[1]s"))
+	c.Set(tag, code.TooLongGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractère", plural.Other, "caractères")),
+		catalog.String("est trop long (maximum %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractère", plural.Other, "caractères")),
+		catalog.String("est trop court (minimum %[1]d ${characters})"),
+	)
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("doit être égal à %[1]s"))
+	c.Set(tag, code.NotEqualField, catalog.String("ne doit pas être égal à %[1]s"))
+	c.Set(tag, code.GreaterThanField, catalog.String("doit être supérieur à %[1]s"))
+	c.Set(tag, code.LessThanField, catalog.String("doit être inférieur à %[1]s"))
+	c.Set(tag, code.RequiredIf, catalog.String("est obligatoire lorsque %[1]s vaut %[2]s"))
+	c.Set(tag, code.RequiredWith, catalog.String("est obligatoire lorsque %[1]s est présent"))
+	c.Set(tag, code.RequiredWithout, catalog.String("est obligatoire lorsque %[1]s est absent"))
+	c.Set(tag, code.ExcludedIf, catalog.String("doit être vide lorsque %[1]s vaut %[2]s"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("doit être antérieur à %[1]v"))
+	c.Set(tag, code.After, catalog.String("doit être postérieur à %[1]v"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("doit être compris entre %[1]v et %[2]v"))
+	c.Set(tag, code.Future, catalog.String("doit être dans le futur"))
+	c.Set(tag, code.Past, catalog.String("doit être dans le passé"))
+	c.Set(tag, code.Weekday, catalog.String("doit être l'un des jours de la semaine %[1]v"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("doit être compris entre %[1]v et %[2]v"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("est obsolète"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s %[2]s"))
+}
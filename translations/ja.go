@@ -11,20 +11,33 @@ func DefaultJapanese(c *catalog.Builder) {
 	tag := language.Japanese
 
 	// type error
-	c.Set(tag, code.NotString, catalog.String("must be any string"))
-	c.Set(tag, code.NotStruct, catalog.String("must be any struct"))
-	c.Set(tag, code.NotStructField, catalog.String("must be any struct field"))
-	c.Set(tag, code.NotArray, catalog.String("must be any array"))
-	c.Set(tag, code.NotMap, catalog.String("must be any map"))
-	c.Set(tag, code.NotAssignable, catalog.String("can't assign to %[1]s"))
+	c.Set(tag, code.NotString, catalog.String("は文字列である必要があります"))
+	c.Set(tag, code.NotStruct, catalog.String("は構造体である必要があります"))
+	c.Set(tag, code.NotStructField, catalog.String("は構造体のフィールドである必要があります"))
+	c.Set(tag, code.NotArray, catalog.String("は配列である必要があります"))
+	c.Set(tag, code.NotMap, catalog.String("はマップである必要があります"))
+	c.Set(tag, code.NotNumeric, catalog.String("は数値である必要があります"))
+	c.Set(tag, code.NotInteger, catalog.String("は整数である必要があります"))
+	c.Set(tag, code.NotBoolean, catalog.String("は真偽値である必要があります"))
+	c.Set(tag, code.NotTime, catalog.String("は日時である必要があります"))
+	c.Set(tag, code.NotDuration, catalog.String("は期間である必要があります"))
+	c.Set(tag, code.NotNull, catalog.String("はnullである必要があります"))
+	c.Set(tag, code.NotIterable, catalog.String("は反復可能な値である必要があります"))
+	c.Set(tag, code.NotAssignable, catalog.String("は%[1]sに代入できません"))
 
 	// not found error
-	c.Set(tag, code.NoKey, catalog.String("requires the value at the key (%[1]v)"))
+	c.Set(tag, code.NoKey, catalog.String("にはキー (%[1]v) の値が必要です"))
+	c.Set(tag, code.NoField, catalog.String("は存在しないフィールド (%[1]s) を参照しています"))
 	c.Set(tag, code.OutOfRange, catalog.String("%[1]d要素よりも多くの要素が必要です"))
 
 	// convert error
 	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
 
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("検証が中断されました (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("は最大の深さ (%[1]d) を超えています"))
+	c.Set(tag, code.CircularReference, catalog.String("は循環参照です"))
+
 	// others
 	c.Set(tag, code.Custom, catalog.String("%[1]v"))
 	c.Set(tag, code.Invalid, catalog.String("は不正な値です"))
@@ -37,14 +50,64 @@ func DefaultJapanese(c *catalog.Builder) {
 	c.Set(tag, code.TooShortLength, catalog.String("は%[1]d文字以上必要です"))
 	c.Set(tag, code.TooLongLen, catalog.String("は%[1]d要素までです"))
 	c.Set(tag, code.TooShortLen, catalog.String("は%[1]d要素以上必要です"))
-	c.Set(tag, code.GreaterThan, catalog.String("は%[1]v以上の値にする必要があります"))
-	c.Set(tag, code.LessThan, catalog.String("は%[1]v以下の値にする必要があります"))
-	c.Set(tag, code.GreaterThanOrEqual, catalog.String("は%[1]vより大きい値にする必要があります"))
-	c.Set(tag, code.LessThanOrEqual, catalog.String("は%[1]vより小さい値にする必要があります"))
+	c.Set(tag, code.GreaterThan, catalog.String("は%[1]vより大きい値にする必要があります"))
+	c.Set(tag, code.LessThan, catalog.String("は%[1]vより小さい値にする必要があります"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("は%[1]v以上の値にする必要があります"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("は%[1]v以下の値にする必要があります"))
 
 	c.Set(tag, code.Inclusion, catalog.String("は %[1]v のいずれかである必要があります"))
 	c.Set(tag, code.RegexpMismatch, catalog.String("は正規表現 (%[1]s) に一致しません"))
 	c.Set(tag, code.InvalidURLFormat, catalog.String("は不正なURLです"))
 	c.Set(tag, code.InvalidScheme, catalog.String("のスキームは %[1]v のいずれかである必要があります"))
 	c.Set(tag, code.InvalidEmailFormat, catalog.String("は不正なメールアドレスです"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("は不正なUUIDです"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("は不正なIPアドレスです"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("は不正なIPv4アドレスです"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("は不正なIPv6アドレスです"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("は不正なCIDR表記です"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("は不正なMACアドレスです"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("は不正なホスト名です"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("は不正な完全修飾ドメイン名です"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("は不正なセマンティックバージョンです"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("は不正なBase64文字列です"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("は不正なカラーコードです"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("は不正な国コードです"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("は不正な通貨コードです"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("は不正な言語タグです"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("はASCII文字のみ使用できます"))
+	c.Set(tag, code.PrintableOnly, catalog.String("は印字可能な文字のみ使用できます"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("は文字と数字のみ使用できます"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("に制御文字を含めることはできません"))
+	c.Set(tag, code.NotNormalized, catalog.String("は%[1]sで正規化されている必要があります"))
+	c.Set(tag, code.TooLongGraphemeLength, catalog.String("は%[1]d文字までです"))
+	c.Set(tag, code.TooShortGraphemeLength, catalog.String("は%[1]d文字以上必要です"))
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("は%[1]sと一致する必要があります"))
+	c.Set(tag, code.NotEqualField, catalog.String("は%[1]sと異なる値にする必要があります"))
+	c.Set(tag, code.GreaterThanField, catalog.String("は%[1]sより大きい値にする必要があります"))
+	c.Set(tag, code.LessThanField, catalog.String("は%[1]sより小さい値にする必要があります"))
+	c.Set(tag, code.RequiredIf, catalog.String("は%[1]sが%[2]sの場合は必須です"))
+	c.Set(tag, code.RequiredWith, catalog.String("は%[1]sを指定する場合は必須です"))
+	c.Set(tag, code.RequiredWithout, catalog.String("は%[1]sを指定しない場合は必須です"))
+	c.Set(tag, code.ExcludedIf, catalog.String("は%[1]sが%[2]sの場合は指定できません"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("は%[1]vより前である必要があります"))
+	c.Set(tag, code.After, catalog.String("は%[1]vより後である必要があります"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("は%[1]vから%[2]vの間である必要があります"))
+	c.Set(tag, code.Future, catalog.String("は未来の日時である必要があります"))
+	c.Set(tag, code.Past, catalog.String("は過去の日時である必要があります"))
+	c.Set(tag, code.Weekday, catalog.String("は %[1]v のいずれかの曜日である必要があります"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("は%[1]vから%[2]vの間である必要があります"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("は非推奨です"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s%[2]s"))
 }
@@ -0,0 +1,113 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultKorean is a CatalogRegistrationFunc for Korean.
+func DefaultKorean(c *catalog.Builder) {
+	tag := language.Korean
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("문자열이어야 합니다"))
+	c.Set(tag, code.NotStruct, catalog.String("구조체여야 합니다"))
+	c.Set(tag, code.NotStructField, catalog.String("구조체 필드여야 합니다"))
+	c.Set(tag, code.NotArray, catalog.String("배열이어야 합니다"))
+	c.Set(tag, code.NotMap, catalog.String("맵이어야 합니다"))
+	c.Set(tag, code.NotNumeric, catalog.String("숫자여야 합니다"))
+	c.Set(tag, code.NotInteger, catalog.String("정수여야 합니다"))
+	c.Set(tag, code.NotBoolean, catalog.String("불리언이어야 합니다"))
+	c.Set(tag, code.NotTime, catalog.String("시각이어야 합니다"))
+	c.Set(tag, code.NotDuration, catalog.String("기간이어야 합니다"))
+	c.Set(tag, code.NotNull, catalog.String("null이어야 합니다"))
+	c.Set(tag, code.NotIterable, catalog.String("반복 가능한 값이어야 합니다"))
+	c.Set(tag, code.NotAssignable, catalog.String("%[1]s에 할당할 수 없습니다"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("키 (%[1]v)의 값이 필요합니다"))
+	c.Set(tag, code.NoField, catalog.String("알 수 없는 필드 (%[1]s)를 참조합니다"))
+	c.Set(tag, code.OutOfRange, catalog.String("%[1]d개보다 많은 요소가 필요합니다"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("검증이 취소되었습니다 (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("최대 깊이 (%[1]d)를 초과합니다"))
+	c.Set(tag, code.CircularReference, catalog.String("순환 참조입니다"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("유효하지 않습니다"))
+	c.Set(tag, code.Required, catalog.String("필수 항목입니다"))
+	c.Set(tag, code.NonZero, catalog.String("비워 둘 수 없습니다"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("지정하는 경우 비워 둘 수 없습니다"))
+	c.Set(tag, code.ZeroOnly, catalog.String("비워 두어야 합니다"))
+
+	c.Set(tag, code.TooLongLength, catalog.String("최대 %[1]d자까지입니다"))
+	c.Set(tag, code.TooShortLength, catalog.String("최소 %[1]d자 이상이어야 합니다"))
+	c.Set(tag, code.TooLongLen, catalog.String("최대 %[1]d개의 요소까지입니다"))
+	c.Set(tag, code.TooShortLen, catalog.String("최소 %[1]d개의 요소가 필요합니다"))
+	c.Set(tag, code.GreaterThan, catalog.String("%[1]v보다 커야 합니다"))
+	c.Set(tag, code.LessThan, catalog.String("%[1]v보다 작아야 합니다"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("%[1]v 이상이어야 합니다"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("%[1]v 이하여야 합니다"))
+
+	c.Set(tag, code.Inclusion, catalog.String("%[1]v 중 하나여야 합니다"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("정규 표현식 (%[1]s)과 일치하지 않습니다"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("유효한 URL이 아닙니다"))
+	c.Set(tag, code.InvalidScheme, catalog.String("스킴은 %[1]v 중 하나여야 합니다"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("유효한 이메일 주소가 아닙니다"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("유효한 UUID가 아닙니다"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("유효한 IP 주소가 아닙니다"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("유효한 IPv4 주소가 아닙니다"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("유효한 IPv6 주소가 아닙니다"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("유효한 CIDR 표기법이 아닙니다"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("유효한 MAC 주소가 아닙니다"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("유효한 호스트 이름이 아닙니다"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("유효한 정규화된 도메인 이름이 아닙니다"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("유효한 시맨틱 버전이 아닙니다"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("유효한 Base64 문자열이 아닙니다"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("유효한 16진수 색상 코드가 아닙니다"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("유효한 국가 코드가 아닙니다"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("유효한 통화 코드가 아닙니다"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("유효한 언어 태그가 아닙니다"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("ASCII 문자만 사용할 수 있습니다"))
+	c.Set(tag, code.PrintableOnly, catalog.String("인쇄 가능한 문자만 사용할 수 있습니다"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("문자와 숫자만 사용할 수 있습니다"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("제어 문자를 포함할 수 없습니다"))
+	c.Set(tag, code.NotNormalized, catalog.String("%[1]s로 정규화되어야 합니다"))
+	c.Set(tag, code.TooLongGraphemeLength, catalog.String("최대 %[1]d자까지입니다"))
+	c.Set(tag, code.TooShortGraphemeLength, catalog.String("최소 %[1]d자 이상이어야 합니다"))
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("%[1]s와(과) 같아야 합니다"))
+	c.Set(tag, code.NotEqualField, catalog.String("%[1]s와(과) 달라야 합니다"))
+	c.Set(tag, code.GreaterThanField, catalog.String("%[1]s보다 커야 합니다"))
+	c.Set(tag, code.LessThanField, catalog.String("%[1]s보다 작아야 합니다"))
+	c.Set(tag, code.RequiredIf, catalog.String("%[1]s이(가) %[2]s인 경우 필수 항목입니다"))
+	c.Set(tag, code.RequiredWith, catalog.String("%[1]s을(를) 지정한 경우 필수 항목입니다"))
+	c.Set(tag, code.RequiredWithout, catalog.String("%[1]s을(를) 지정하지 않은 경우 필수 항목입니다"))
+	c.Set(tag, code.ExcludedIf, catalog.String("%[1]s이(가) %[2]s인 경우 비워 두어야 합니다"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("%[1]v 이전이어야 합니다"))
+	c.Set(tag, code.After, catalog.String("%[1]v 이후여야 합니다"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("%[1]v에서 %[2]v 사이여야 합니다"))
+	c.Set(tag, code.Future, catalog.String("미래의 시각이어야 합니다"))
+	c.Set(tag, code.Past, catalog.String("과거의 시각이어야 합니다"))
+	c.Set(tag, code.Weekday, catalog.String("%[1]v 중 하나의 요일이어야 합니다"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("%[1]v에서 %[2]v 사이여야 합니다"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("더 이상 사용되지 않습니다"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s은(는) %[2]s"))
+}
@@ -0,0 +1,295 @@
+package translations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+type (
+	// gotextMessages is the format of the message files used by the gotext command (e.g. out.gotext.json).
+	gotextMessages struct {
+		Language string           `json:"language"`
+		Messages []*gotextMessage `json:"messages"`
+	}
+	gotextMessage struct {
+		ID           messageID            `json:"id"`
+		Key          string               `json:"key,omitempty"`
+		Translation  *messageText         `json:"translation,omitempty"`
+		Placeholders []*gotextPlaceholder `json:"placeholders,omitempty"`
+	}
+	gotextPlaceholder struct {
+		ID     string `json:"id"`
+		String string `json:"string"`
+		ArgNum int    `json:"argNum"`
+	}
+	// messageID is the ID of the message. It is a string or an array of strings in the file.
+	messageID []string
+	// messageText is the translated text. It is a string or an object in the file.
+	messageText struct {
+		Msg    string                  `json:"msg,omitempty"`
+		Select *messageSelect          `json:"select,omitempty"`
+		Var    map[string]*messageText `json:"var,omitempty"`
+	}
+	messageSelect struct {
+		Feature string                  `json:"feature"`
+		Arg     string                  `json:"arg"`
+		Cases   map[string]*messageText `json:"cases"`
+	}
+	catalogEntry struct {
+		tag  language.Tag
+		key  string
+		msgs []catalog.Message
+	}
+)
+
+// GotextFS reads the message files of the gotext format (e.g. locales/ja/out.gotext.json) that match the pattern in the fsys,
+// and returns a CatalogRegistrationFunc that registers the translations.
+// The pattern syntax is the same as fs.Glob.
+//
+// The key of each message is the key field, or the first id when it is empty.
+// The placeholders in the translation (e.g. {Count}) are replaced with the string of the placeholder (e.g. %[1]d).
+// Untranslated messages are ignored.
+func GotextFS(fsys fs.FS, pattern string) (CatalogRegistrationFunc, error) {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*catalogEntry, 0)
+	for _, file := range files {
+		var msgs gotextMessages
+		if err := readJSONFile(fsys, file, &msgs); err != nil {
+			return nil, err
+		}
+		tag, err := language.Parse(msgs.Language)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		for _, msg := range msgs.Messages {
+			if msg.Translation == nil || msg.Translation.isEmpty() {
+				continue
+			}
+			key := msg.Key
+			if key == "" && len(msg.ID) > 0 {
+				key = msg.ID[0]
+			}
+
+			argNums := make(map[string]int)
+			replacer := make([]string, 0, len(msg.Placeholders)*2)
+			for _, ph := range msg.Placeholders {
+				argNums[ph.ID] = ph.ArgNum
+				replacer = append(replacer, "{"+ph.ID+"}", ph.String)
+			}
+
+			catalogMsgs, err := msg.Translation.messages(argNums, strings.NewReplacer(replacer...))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", file, key, err)
+			}
+			entries = append(entries, &catalogEntry{tag: tag, key: key, msgs: catalogMsgs})
+		}
+	}
+	return registrationFunc(entries), nil
+}
+
+// MessagesFS reads the message files that match the pattern in the fsys, and returns a CatalogRegistrationFunc that registers the translations.
+// The pattern syntax is the same as fs.Glob.
+//
+// Each file is a JSON object from the code (or any key) to the message, and the language is the file name without the extension (e.g. locales/pt-BR.json).
+// The message is a string, or an object that has the same format as the translation of gotext.
+// Since there is no placeholder, the arg of the select is the argument number.
+//
+//	{
+//	  "required": "is required",
+//	  "too_long_length": {
+//	    "select": {
+//	      "feature": "plural",
+//	      "arg": "1",
+//	      "cases": {
+//	        "one":   "is too long (maximum is %[1]d character)",
+//	        "other": "is too long (maximum is %[1]d characters)"
+//	      }
+//	    }
+//	  }
+//	}
+func MessagesFS(fsys fs.FS, pattern string) (CatalogRegistrationFunc, error) {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*catalogEntry, 0)
+	for _, file := range files {
+		base := path.Base(file)
+		tag, err := language.Parse(strings.TrimSuffix(base, path.Ext(base)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		var msgs map[string]*messageText
+		if err := readJSONFile(fsys, file, &msgs); err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(msgs))
+		for key := range msgs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			catalogMsgs, err := msgs[key].messages(nil, strings.NewReplacer())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", file, key, err)
+			}
+			entries = append(entries, &catalogEntry{tag: tag, key: key, msgs: catalogMsgs})
+		}
+	}
+	return registrationFunc(entries), nil
+}
+
+func registrationFunc(entries []*catalogEntry) CatalogRegistrationFunc {
+	return func(c *catalog.Builder) {
+		for _, entry := range entries {
+			c.Set(entry.tag, entry.key, entry.msgs...)
+		}
+	}
+}
+
+func readJSONFile(fsys fs.FS, file string, v interface{}) error {
+	b, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+func (id *messageID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*id = messageID{s}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(id))
+}
+
+func (text *messageText) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*text = messageText{Msg: s}
+		return nil
+	}
+	type plain messageText
+	return json.Unmarshal(b, (*plain)(text))
+}
+
+func (text *messageText) isEmpty() bool {
+	return text.Msg == "" && text.Select == nil
+}
+
+// messages returns the catalog.Message list of the text.
+// argNums is used to resolve the arg of the select. When it is not found, the arg is parsed as the argument number.
+func (text *messageText) messages(argNums map[string]int, replacer *strings.Replacer) ([]catalog.Message, error) {
+	msgs := make([]catalog.Message, 0, len(text.Var)+1)
+
+	names := make([]string, 0, len(text.Var))
+	for name := range text.Var {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		msg, err := text.Var[name].message(argNums, replacer)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, catalog.Var(name, msg))
+	}
+
+	msg, err := text.message(argNums, replacer)
+	if err != nil {
+		return nil, err
+	}
+	return append(msgs, msg), nil
+}
+
+func (text *messageText) message(argNums map[string]int, replacer *strings.Replacer) (catalog.Message, error) {
+	if text.Select == nil {
+		return catalog.String(replacer.Replace(text.Msg)), nil
+	}
+
+	sel := text.Select
+	if sel.Feature != "plural" {
+		return nil, fmt.Errorf("unsupported select feature: %s", sel.Feature)
+	}
+	argNum, ok := argNums[sel.Arg]
+	if !ok {
+		n, err := strconv.Atoi(sel.Arg)
+		if err != nil {
+			return nil, fmt.Errorf("unknown select arg: %s", sel.Arg)
+		}
+		argNum = n
+	}
+
+	selectors := make([]string, 0, len(sel.Cases))
+	for selector := range sel.Cases {
+		selectors = append(selectors, selector)
+	}
+	// NOTE: the cases are matched in order, so the specific cases have to precede the plural categories.
+	sort.Slice(selectors, func(i, j int) bool {
+		ri, rj := selectorRank(selectors[i]), selectorRank(selectors[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return selectors[i] < selectors[j]
+	})
+
+	cases := make([]interface{}, 0, len(selectors)*2)
+	for _, selector := range selectors {
+		if !isValidSelector(selector) {
+			return nil, fmt.Errorf("invalid plural selector: %s", selector)
+		}
+		msg, err := sel.Cases[selector].message(argNums, replacer)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, selector, msg)
+	}
+	return plural.Selectf(argNum, "", cases...), nil
+}
+
+func selectorRank(selector string) int {
+	switch {
+	case strings.HasPrefix(selector, "="):
+		return 0
+	case strings.HasPrefix(selector, "<"):
+		return 1
+	case selector == "other":
+		return 3
+	default:
+		return 2
+	}
+}
+
+func isValidSelector(selector string) bool {
+	switch selector {
+	case "zero", "one", "two", "few", "many", "other":
+		return true
+	}
+	if strings.HasPrefix(selector, "=") || strings.HasPrefix(selector, "<") {
+		_, err := strconv.ParseUint(selector[1:], 10, 32)
+		return err == nil
+	}
+	return false
+}
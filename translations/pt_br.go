@@ -0,0 +1,132 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultBrazilianPortuguese is a CatalogRegistrationFunc for Brazilian Portuguese.
+func DefaultBrazilianPortuguese(c *catalog.Builder) {
+	tag := language.BrazilianPortuguese
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("deve ser uma string"))
+	c.Set(tag, code.NotStruct, catalog.String("deve ser uma struct"))
+	c.Set(tag, code.NotStructField, catalog.String("deve ser um campo de struct"))
+	c.Set(tag, code.NotArray, catalog.String("deve ser um array"))
+	c.Set(tag, code.NotMap, catalog.String("deve ser um mapa"))
+	c.Set(tag, code.NotNumeric, catalog.String("deve ser um número"))
+	c.Set(tag, code.NotInteger, catalog.String("deve ser um número inteiro"))
+	c.Set(tag, code.NotBoolean, catalog.String("deve ser um booleano"))
+	c.Set(tag, code.NotTime, catalog.String("deve ser uma data"))
+	c.Set(tag, code.NotDuration, catalog.String("deve ser uma duração"))
+	c.Set(tag, code.NotNull, catalog.String("deve ser nulo"))
+	c.Set(tag, code.NotIterable, catalog.String("deve ser um valor iterável"))
+	c.Set(tag, code.NotAssignable, catalog.String("não pode ser atribuído a %[1]s"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("requer o valor da chave (%[1]v)"))
+	c.Set(tag, code.NoField, catalog.String("faz referência ao campo desconhecido (%[1]s)"))
+	c.Set(tag, code.OutOfRange, catalog.String("requer mais de %[1]d elementos"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("a validação foi cancelada (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("excede a profundidade máxima (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("é uma referência circular"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("é inválido"))
+	c.Set(tag, code.Required, catalog.String("é obrigatório"))
+	c.Set(tag, code.NonZero, catalog.String("não pode ficar em branco (ou zero)"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("não pode ficar em branco (ou zero) se especificado"))
+	c.Set(tag, code.ZeroOnly, catalog.String("deve ficar em branco"))
+
+	c.Set(tag, code.TooLongLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractere", plural.Other, "caracteres")),
+		catalog.String("é muito longo (máximo de %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractere", plural.Other, "caracteres")),
+		catalog.String("é muito curto (mínimo de %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooLongLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "elemento", plural.Other, "elementos")),
+		catalog.String("tem elementos demais (máximo de %[1]d ${elements})"),
+	)
+	c.Set(tag, code.TooShortLen,
+		catalog.Var("elements", plural.Selectf(1, "", plural.One, "elemento", plural.Other, "elementos")),
+		catalog.String("tem elementos de menos (mínimo de %[1]d ${elements})"),
+	)
+	c.Set(tag, code.GreaterThan, catalog.String("deve ser maior que %[1]v"))
+	c.Set(tag, code.LessThan, catalog.String("deve ser menor que %[1]v"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("deve ser maior ou igual a %[1]v"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("deve ser menor ou igual a %[1]v"))
+
+	c.Set(tag, code.Inclusion, catalog.String("não está incluído em %[1]v"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("não corresponde à expressão regular (%[1]s)"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("não é uma URL válida"))
+	c.Set(tag, code.InvalidScheme, catalog.String("tem um esquema que não está incluído em %[1]v"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("não é um endereço de e-mail válido"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("não é um UUID válido"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("não é um endereço IP válido"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("não é um endereço IPv4 válido"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("não é um endereço IPv6 válido"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("não é uma notação CIDR válida"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("não é um endereço MAC válido"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("não é um nome de host válido"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("não é um nome de domínio totalmente qualificado válido"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("não é uma versão semântica válida"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("não é uma string base64 válida"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("não é um código de cor hexadecimal válido"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("não é um código de país válido"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("não é um código de moeda válido"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("não é uma tag de idioma válida"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("deve conter apenas caracteres ASCII"))
+	c.Set(tag, code.PrintableOnly, catalog.String("deve conter apenas caracteres imprimíveis"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("deve conter apenas letras e dígitos"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("não pode conter caracteres de controle"))
+	c.Set(tag, code.NotNormalized, catalog.String("deve estar normalizado em %[1]s"))
+	c.Set(tag, code.TooLongGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractere", plural.Other, "caracteres")),
+		catalog.String("é muito longo (máximo de %[1]d ${characters})"),
+	)
+	c.Set(tag, code.TooShortGraphemeLength,
+		catalog.Var("characters", plural.Selectf(1, "", plural.One, "caractere", plural.Other, "caracteres")),
+		catalog.String("é muito curto (mínimo de %[1]d ${characters})"),
+	)
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("deve ser igual a %[1]s"))
+	c.Set(tag, code.NotEqualField, catalog.String("não pode ser igual a %[1]s"))
+	c.Set(tag, code.GreaterThanField, catalog.String("deve ser maior que %[1]s"))
+	c.Set(tag, code.LessThanField, catalog.String("deve ser menor que %[1]s"))
+	c.Set(tag, code.RequiredIf, catalog.String("é obrigatório quando %[1]s é %[2]s"))
+	c.Set(tag, code.RequiredWith, catalog.String("é obrigatório quando %[1]s está presente"))
+	c.Set(tag, code.RequiredWithout, catalog.String("é obrigatório quando %[1]s não está presente"))
+	c.Set(tag, code.ExcludedIf, catalog.String("deve ficar em branco quando %[1]s é %[2]s"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("deve ser anterior a %[1]v"))
+	c.Set(tag, code.After, catalog.String("deve ser posterior a %[1]v"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("deve estar entre %[1]v e %[2]v"))
+	c.Set(tag, code.Future, catalog.String("deve estar no futuro"))
+	c.Set(tag, code.Past, catalog.String("deve estar no passado"))
+	c.Set(tag, code.Weekday, catalog.String("deve ser um dos dias da semana %[1]v"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("deve estar entre %[1]v e %[2]v"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("está obsoleto"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s %[2]s"))
+}
@@ -2,6 +2,8 @@
 package translations
 
 import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 	"golang.org/x/text/message/catalog"
 )
 
@@ -14,14 +16,40 @@ type (
 	CatalogRegistrationFunc func(c *catalog.Builder)
 )
 
+const (
+	// LabelTemplateKey is the key of the sentence template that combines the label of the field with the message.
+	// The first argument is the label, and the second is the message.
+	// Since the key is the English template, it is used as is when the catalog does not have the translation.
+	LabelTemplateKey = "%[1]s %[2]s"
+	// LabelKeyPrefix is the prefix of the keys of the labels, so that the labels do not conflict with other keys.
+	// See also LabelKey.
+	LabelKeyPrefix = "label:"
+)
+
 var (
 	// AllPredefinedCatalogRegistrationFunc is all catalog registration functions predefined by this library.
 	AllPredefinedCatalogRegistrationFunc = [...]CatalogRegistrationFunc{
 		DefaultEnglish,
 		DefaultJapanese,
+		DefaultGerman,
+		DefaultFrench,
+		DefaultSpanish,
+		DefaultBrazilianPortuguese,
+		DefaultSimplifiedChinese,
+		DefaultKorean,
 	}
 )
 
+// LabelKey returns the key of the translation of the label.
+// For example, the translation of `label:"Email address"` is registered as follows.
+//
+//	c.SetString(language.Japanese, translations.LabelKey("Email address"), "メールアドレス")
+//
+// The translation is a format string, so "%" has to be escaped as "%%".
+func LabelKey(label string) string {
+	return LabelKeyPrefix + label
+}
+
 // NewCatalog returns a new catalog instance.
 func NewCatalog(opts ...catalog.Option) *Catalog {
 	return &Catalog{Catalog: catalog.NewBuilder(opts...)}
@@ -31,3 +59,58 @@ func NewCatalog(opts ...catalog.Option) *Catalog {
 func (t *Catalog) Set(f CatalogRegistrationFunc) {
 	f(t.Catalog.(*catalog.Builder))
 }
+
+// Match returns the language of the catalog that best matches the preferred languages.
+// The preferred languages are checked in order, and a language is matched only with high confidence and the same region
+// when both have the region. When no language matches, it returns English if the catalog has it, or the first language of the catalog.
+//
+// For example, zh-TW (Traditional Chinese) and pt-PT do not match zh-Hans and pt-BR, and they fall back to English.
+func (t *Catalog) Match(prefs ...language.Tag) language.Tag {
+	supported := make([]language.Tag, 0)
+	for _, tag := range t.Languages() {
+		if tag == language.English {
+			// NOTE: the first language is used as the fallback.
+			supported = append([]language.Tag{tag}, supported...)
+		} else {
+			supported = append(supported, tag)
+		}
+	}
+	if len(supported) == 0 {
+		return language.English
+	}
+
+	matcher := language.NewMatcher(supported)
+	for _, pref := range prefs {
+		_, index, confidence := matcher.Match(pref)
+		if confidence < language.High || !sameRegion(pref, supported[index]) {
+			continue
+		}
+		return supported[index]
+	}
+	return supported[0]
+}
+
+// MatchAcceptLanguage is equiv to Match, but it receives the value of the Accept-Language header.
+// When the value is invalid, it is treated as empty.
+func (t *Catalog) MatchAcceptLanguage(acceptLanguage string) language.Tag {
+	prefs, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	return t.Match(prefs...)
+}
+
+// NewPrinter returns a message.Printer of the language that best matches the preferred languages. See also Match.
+func (t *Catalog) NewPrinter(prefs ...language.Tag) *message.Printer {
+	return message.NewPrinter(t.Match(prefs...), message.Catalog(t))
+}
+
+// NewAcceptLanguagePrinter returns a message.Printer of the language that best matches the value of the Accept-Language header.
+// See also MatchAcceptLanguage.
+func (t *Catalog) NewAcceptLanguagePrinter(acceptLanguage string) *message.Printer {
+	return message.NewPrinter(t.MatchAcceptLanguage(acceptLanguage), message.Catalog(t))
+}
+
+// sameRegion returns false, when both tags have the explicit region and they are different.
+func sameRegion(a, b language.Tag) bool {
+	aRegion, aConfidence := a.Region()
+	bRegion, bConfidence := b.Region()
+	return aConfidence != language.Exact || bConfidence != language.Exact || aRegion == bRegion
+}
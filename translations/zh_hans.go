@@ -0,0 +1,113 @@
+package translations
+
+import (
+	"github.com/soranoba/valis/code"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// DefaultSimplifiedChinese is a CatalogRegistrationFunc for Simplified Chinese.
+func DefaultSimplifiedChinese(c *catalog.Builder) {
+	tag := language.SimplifiedChinese
+
+	// type error
+	c.Set(tag, code.NotString, catalog.String("必须是字符串"))
+	c.Set(tag, code.NotStruct, catalog.String("必须是结构体"))
+	c.Set(tag, code.NotStructField, catalog.String("必须是结构体字段"))
+	c.Set(tag, code.NotArray, catalog.String("必须是数组"))
+	c.Set(tag, code.NotMap, catalog.String("必须是映射"))
+	c.Set(tag, code.NotNumeric, catalog.String("必须是数字"))
+	c.Set(tag, code.NotInteger, catalog.String("必须是整数"))
+	c.Set(tag, code.NotBoolean, catalog.String("必须是布尔值"))
+	c.Set(tag, code.NotTime, catalog.String("必须是时间"))
+	c.Set(tag, code.NotDuration, catalog.String("必须是时长"))
+	c.Set(tag, code.NotNull, catalog.String("必须是null"))
+	c.Set(tag, code.NotIterable, catalog.String("必须是可迭代的值"))
+	c.Set(tag, code.NotAssignable, catalog.String("无法赋值给%[1]s"))
+
+	// not found error
+	c.Set(tag, code.NoKey, catalog.String("需要键 (%[1]v) 的值"))
+	c.Set(tag, code.NoField, catalog.String("引用了未知的字段 (%[1]s)"))
+	c.Set(tag, code.OutOfRange, catalog.String("需要多于%[1]d个元素"))
+
+	// convert error
+	c.Set(tag, code.ConversionFailed, catalog.String("%[1]v"))
+
+	// interruption error
+	c.Set(tag, code.Canceled, catalog.String("验证已取消 (%[1]v)"))
+	c.Set(tag, code.MaxDepthExceeded, catalog.String("超过了最大深度 (%[1]d)"))
+	c.Set(tag, code.CircularReference, catalog.String("是循环引用"))
+
+	// others
+	c.Set(tag, code.Custom, catalog.String("%[1]v"))
+	c.Set(tag, code.Invalid, catalog.String("无效"))
+	c.Set(tag, code.Required, catalog.String("是必填项"))
+	c.Set(tag, code.NonZero, catalog.String("不能为空"))
+	c.Set(tag, code.NilOrNonZero, catalog.String("指定时不能为空"))
+	c.Set(tag, code.ZeroOnly, catalog.String("必须为空"))
+
+	c.Set(tag, code.TooLongLength, catalog.String("最多%[1]d个字符"))
+	c.Set(tag, code.TooShortLength, catalog.String("至少需要%[1]d个字符"))
+	c.Set(tag, code.TooLongLen, catalog.String("最多%[1]d个元素"))
+	c.Set(tag, code.TooShortLen, catalog.String("至少需要%[1]d个元素"))
+	c.Set(tag, code.GreaterThan, catalog.String("必须大于%[1]v"))
+	c.Set(tag, code.LessThan, catalog.String("必须小于%[1]v"))
+	c.Set(tag, code.GreaterThanOrEqual, catalog.String("必须大于或等于%[1]v"))
+	c.Set(tag, code.LessThanOrEqual, catalog.String("必须小于或等于%[1]v"))
+
+	c.Set(tag, code.Inclusion, catalog.String("必须是 %[1]v 中的一个"))
+	c.Set(tag, code.RegexpMismatch, catalog.String("与正则表达式 (%[1]s) 不匹配"))
+	c.Set(tag, code.InvalidURLFormat, catalog.String("不是有效的URL"))
+	c.Set(tag, code.InvalidScheme, catalog.String("的协议必须是 %[1]v 中的一个"))
+	c.Set(tag, code.InvalidEmailFormat, catalog.String("不是有效的电子邮件地址"))
+
+	// format error
+	c.Set(tag, code.InvalidUUIDFormat, catalog.String("不是有效的UUID"))
+	c.Set(tag, code.InvalidIPFormat, catalog.String("不是有效的IP地址"))
+	c.Set(tag, code.InvalidIPv4Format, catalog.String("不是有效的IPv4地址"))
+	c.Set(tag, code.InvalidIPv6Format, catalog.String("不是有效的IPv6地址"))
+	c.Set(tag, code.InvalidCIDRFormat, catalog.String("不是有效的CIDR表示法"))
+	c.Set(tag, code.InvalidMACFormat, catalog.String("不是有效的MAC地址"))
+	c.Set(tag, code.InvalidHostnameFormat, catalog.String("不是有效的主机名"))
+	c.Set(tag, code.InvalidFQDNFormat, catalog.String("不是有效的完全限定域名"))
+	c.Set(tag, code.InvalidSemverFormat, catalog.String("不是有效的语义化版本"))
+	c.Set(tag, code.InvalidBase64Format, catalog.String("不是有效的Base64字符串"))
+	c.Set(tag, code.InvalidHexColorFormat, catalog.String("不是有效的十六进制颜色代码"))
+	c.Set(tag, code.InvalidCountryCode, catalog.String("不是有效的国家代码"))
+	c.Set(tag, code.InvalidCurrencyCode, catalog.String("不是有效的货币代码"))
+	c.Set(tag, code.InvalidLanguageTag, catalog.String("不是有效的语言标签"))
+
+	// string content error
+	c.Set(tag, code.ASCIIOnly, catalog.String("只能包含ASCII字符"))
+	c.Set(tag, code.PrintableOnly, catalog.String("只能包含可打印字符"))
+	c.Set(tag, code.AlphanumericOnly, catalog.String("只能包含字母和数字"))
+	c.Set(tag, code.NoControlCharacters, catalog.String("不能包含控制字符"))
+	c.Set(tag, code.NotNormalized, catalog.String("必须以%[1]s规范化"))
+	c.Set(tag, code.TooLongGraphemeLength, catalog.String("最多%[1]d个字符"))
+	c.Set(tag, code.TooShortGraphemeLength, catalog.String("至少需要%[1]d个字符"))
+
+	// cross-field error
+	c.Set(tag, code.EqualField, catalog.String("必须等于%[1]s"))
+	c.Set(tag, code.NotEqualField, catalog.String("必须不同于%[1]s"))
+	c.Set(tag, code.GreaterThanField, catalog.String("必须大于%[1]s"))
+	c.Set(tag, code.LessThanField, catalog.String("必须小于%[1]s"))
+	c.Set(tag, code.RequiredIf, catalog.String("在%[1]s为%[2]s时是必填项"))
+	c.Set(tag, code.RequiredWith, catalog.String("在指定%[1]s时是必填项"))
+	c.Set(tag, code.RequiredWithout, catalog.String("在未指定%[1]s时是必填项"))
+	c.Set(tag, code.ExcludedIf, catalog.String("在%[1]s为%[2]s时必须为空"))
+
+	// time error
+	c.Set(tag, code.Before, catalog.String("必须早于%[1]v"))
+	c.Set(tag, code.After, catalog.String("必须晚于%[1]v"))
+	c.Set(tag, code.TimeOutOfRange, catalog.String("必须介于%[1]v和%[2]v之间"))
+	c.Set(tag, code.Future, catalog.String("必须是将来的时间"))
+	c.Set(tag, code.Past, catalog.String("必须是过去的时间"))
+	c.Set(tag, code.Weekday, catalog.String("必须是 %[1]v 中的一个星期"))
+	c.Set(tag, code.DurationOutOfRange, catalog.String("必须介于%[1]v和%[2]v之间"))
+
+	// warning
+	c.Set(tag, code.Deprecated, catalog.String("已弃用"))
+
+	// label
+	c.Set(tag, LabelTemplateKey, catalog.String("%[1]s%[2]s"))
+}
@@ -1,6 +1,13 @@
 package valis
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/soranoba/valis/code"
+)
 
 type (
 	// Validator provides validation methods.
@@ -8,9 +15,18 @@ type (
 	Validator struct {
 		commonRules               []Rule
 		errorCollectorFactoryFunc ErrorCollectorFactoryFunc
+		maxErrors                 int
+		maxDepth                  int
+		concurrency               int
 
+		ctx            context.Context
+		canceled       *int32
 		loc            *Location
+		node           *valueNode
 		errorCollector ErrorCollector
+		// visited is the number of the values of the current location and its ancestors by the pointerIdentity.
+		// It is used to detect the circular references without walking the ancestors.
+		visited map[pointerIdentity]int
 	}
 	// CloneOpts is an option of Clone.
 	CloneOpts struct {
@@ -25,12 +41,46 @@ type (
 	}
 )
 
+type (
+	// valueNode is the value of a location. It is linked to the value of the parent location.
+	valueNode struct {
+		parent *valueNode
+		value  interface{}
+		// ref is the reflect.Value of the location. It is used to write the transformed value back.
+		// When key is valid, ref is the map and key is the key of the value.
+		ref reflect.Value
+		key reflect.Value
+		// mapLock guards the map of ref, when the map is shared with other goroutines (e.g. ParallelEachValues).
+		mapLock *sync.Mutex
+		// embedded is true, when the fields of the value are promoted to the parent struct and verified as the fields of it.
+		embedded bool
+		// depth is the number of ancestors.
+		depth int
+		// origin is the node replaced by the node (e.g. the node of the value before conversion by To).
+		origin *valueNode
+		// checked is true, when the node has been checked whether it is a circular reference. See Validator.enter.
+		checked bool
+		// circular is true, when the node is a circular reference. The rules do not descend from the node.
+		circular bool
+		// id is the pointerIdentity added to the visited of the Validator, when visited is true.
+		id      pointerIdentity
+		visited bool
+	}
+	// pointerIdentity identifies the value referred by a pointer, map or slice.
+	// The type is needed, because a struct and its first field have the same address.
+	pointerIdentity struct {
+		ptr uintptr
+		ty  reflect.Type
+	}
+)
+
 // NewValidator returns a new Validator.
 func NewValidator() *Validator {
 	v := &Validator{
 		errorCollectorFactoryFunc: func() ErrorCollector {
 			return NewStandardErrorCollector(DefaultLocationNameResolver)
 		},
+		ctx: context.Background(),
 		loc: newRootLocation(),
 	}
 	return v
@@ -51,6 +101,26 @@ func (v *Validator) SetErrorCollectorFactoryFunc(f ErrorCollectorFactoryFunc) {
 	v.errorCollectorFactoryFunc = f
 }
 
+// SetMaxErrors is update the maximum number of errors.
+// When the number of errors reaches max, the rules stop traversal and the remaining errors are not collected.
+// For example, SetMaxErrors(1) means fail-fast. If max is zero or less, the number of errors is unlimited.
+func (v *Validator) SetMaxErrors(max int) {
+	v.maxErrors = max
+}
+
+// SetMaxDepth is update the maximum depth of the locations.
+// When the rules descend deeper than max, they stop descending and the error of code.MaxDepthExceeded is added.
+// If max is zero or less, the depth is unlimited.
+func (v *Validator) SetMaxDepth(max int) {
+	v.maxDepth = max
+}
+
+// SetConcurrency is update the number of workers used by ParallelEach and ParallelEachValues.
+// If n is zero or less, runtime.GOMAXPROCS(0) is used.
+func (v *Validator) SetConcurrency(n int) {
+	v.concurrency = n
+}
+
 // Clone returns a new Validator inheriting the settings.
 func (v *Validator) Clone(opts *CloneOpts) *Validator {
 	newValidator := *v
@@ -59,7 +129,11 @@ func (v *Validator) Clone(opts *CloneOpts) *Validator {
 	} else {
 		newValidator.errorCollector = opts.ErrorCollector
 	}
+	// NOTE: the visited is shared with the new Validator that inherits the location, because it has the same ancestors.
+	// So, the new Validator must not be used concurrently with others. See also parallelize.
 	if !opts.InheritLocation {
+		newValidator.node = nil
+		newValidator.visited = make(map[pointerIdentity]int)
 		if opts.Location != nil {
 			newValidator.loc = opts.Location
 		} else {
@@ -69,67 +143,313 @@ func (v *Validator) Clone(opts *CloneOpts) *Validator {
 	return &newValidator
 }
 
+// Context returns the context.Context given to ValidateContext.
+// When the validation is started by Validate, it returns context.Background().
+func (v *Validator) Context() context.Context {
+	return v.ctx
+}
+
 // Location returns a current location.
 func (v *Validator) Location() *Location {
 	return v.loc
 }
 
+// Parent returns the value of the parent location.
+// For example, it returns the struct when validating the field value, and the slice when validating the element.
+// It returns nil, when the current location is the root.
+func (v *Validator) Parent() interface{} {
+	return v.node.parentValue()
+}
+
+// Root returns the value given to Validate.
+func (v *Validator) Root() interface{} {
+	return v.node.rootValue()
+}
+
 // DiveField moves from the current position to the next location specified the field and performs validation processing.
 // Do not use it outside of Rules.
 func (v *Validator) DiveField(field *reflect.StructField, f func(v *Validator)) {
-	loc := v.loc
-	v.loc = loc.FieldLocation(field)
-	f(v)
-	v.loc = loc
+	v.dive(v.loc.FieldLocation(field), &valueNode{}, f)
 }
 
 // DiveIndex moves from the current position to the next location specified the index and performs validation processing.
 // Do not use it outside of Rules.
 func (v *Validator) DiveIndex(index int, f func(v *Validator)) {
-	loc := v.loc
-	v.loc = loc.IndexLocation(index)
-	f(v)
-	v.loc = loc
+	v.dive(v.loc.IndexLocation(index), &valueNode{}, f)
 }
 
 // DiveMapKey moves from the current position to the next location specified the key and performs validation processing.
 // Do not use it outside of Rules.
 func (v *Validator) DiveMapKey(key interface{}, f func(v *Validator)) {
-	loc := v.loc
-	v.loc = loc.MapKeyLocation(key)
-	f(v)
-	v.loc = loc
+	v.dive(v.loc.MapKeyLocation(key), &valueNode{}, f)
 }
 
 // DiveMapValue moves from the current position to the next location specified the key and performs validation processing.
 // Do not use it outside of Rules.
 func (v *Validator) DiveMapValue(key interface{}, f func(v *Validator)) {
-	loc := v.loc
-	v.loc = loc.MapValueLocation(key)
+	v.dive(v.loc.MapValueLocation(key), &valueNode{}, f)
+}
+
+// dive moves from the current position to the loc and performs validation processing.
+//
+// It does not perform it, when the location is deeper than the max depth or the current value is a circular reference.
+// See also Validator.enter.
+func (v *Validator) dive(loc *Location, node *valueNode, f func(v *Validator)) {
+	if !v.enter() {
+		return
+	}
+	parentLoc, parentNode := v.loc, v.node
+	node.parent = parentNode
+	if parentNode != nil {
+		node.depth = parentNode.depth + 1
+	}
+	if v.maxDepth > 0 && node.depth > v.maxDepth {
+		v.ErrorCollector().Add(loc, NewError(code.MaxDepthExceeded, node.refInterface(), v.maxDepth))
+		return
+	}
+	v.loc, v.node = loc, node
 	f(v)
-	v.loc = loc
+	v.leave(node)
+	v.loc, v.node = parentLoc, parentNode
+}
+
+// setValue saves the validating value of the current location.
+func (v *Validator) setValue(value interface{}) {
+	if v.node == nil {
+		v.node = &valueNode{}
+	}
+	v.node.value = value
+}
+
+// enter checks whether the rules can descend from the value of the current location, before descending from it.
+//
+// It returns false, when the value is a circular reference.
+// A circular reference is the pointer (or map, slice) that refers to the value of any ancestor,
+// and it is reported as an error of code.CircularReference because the value can not be validated to the end.
+// The rules of the location are performed even if it is a circular reference, because they do not descend from it.
+// Otherwise, the value is added to the visited until the location is left.
+func (v *Validator) enter() bool {
+	node := v.node
+	if node == nil {
+		return true
+	}
+	if node.checked {
+		return !node.circular
+	}
+	node.checked = true
+	id, ok := node.identify()
+	if !ok {
+		return true
+	}
+	if v.visited[id] > 0 {
+		// NOTE: the value of the node replaced by it is not the value of any ancestor.
+		for origin := node.origin; origin != nil; origin = origin.origin {
+			if origin.visited && origin.id == id {
+				return true
+			}
+		}
+		node.circular = true
+		value := node.value
+		if node.ref.IsValid() {
+			value = node.refInterface()
+		}
+		v.ErrorCollector().Add(v.loc, NewError(code.CircularReference, value))
+		return false
+	}
+	if v.visited == nil {
+		v.visited = make(map[pointerIdentity]int)
+	}
+	v.visited[id]++
+	node.id, node.visited = id, true
+	return true
+}
+
+// leave removes the value of the node from the visited, when the validation of the location is finished.
+func (v *Validator) leave(node *valueNode) {
+	if !node.visited || v.visited == nil {
+		return
+	}
+	if v.visited[node.id]--; v.visited[node.id] <= 0 {
+		delete(v.visited, node.id)
+	}
+	node.visited = false
+}
+
+// refValue returns the reflect.Value of the node referred by the ref.
+// When the node does not have the ref, it returns the zero Value.
+func (node *valueNode) refValue() reflect.Value {
+	if node.key.IsValid() {
+		if node.mapLock != nil {
+			node.mapLock.Lock()
+			defer node.mapLock.Unlock()
+		}
+		return node.ref.MapIndex(node.key)
+	}
+	return node.ref
+}
+
+// refInterface returns the value of the node referred by the ref, or nil.
+func (node *valueNode) refInterface() interface{} {
+	if val := node.refValue(); val.IsValid() && val.CanInterface() {
+		return val.Interface()
+	}
+	return nil
+}
+
+// identify returns the pointerIdentity of the value referred by the ref, or the value when the node does not have the ref.
+func (node *valueNode) identify() (pointerIdentity, bool) {
+	val := node.refValue()
+	if !val.IsValid() {
+		val = reflect.ValueOf(node.value)
+	}
+	return identifyPointer(val)
+}
+
+// identifyPointer returns the pointerIdentity, when the value is a non-nil pointer, map or non-empty slice.
+func identifyPointer(val reflect.Value) (pointerIdentity, bool) {
+	for val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if val.IsNil() {
+			return pointerIdentity{}, false
+		}
+	case reflect.Slice:
+		if val.Len() == 0 {
+			return pointerIdentity{}, false
+		}
+	default:
+		return pointerIdentity{}, false
+	}
+	return pointerIdentity{ptr: val.Pointer(), ty: val.Type()}, true
+}
+
+// parentValue returns the value of the parent node.
+func (node *valueNode) parentValue() interface{} {
+	if node == nil || node.parent == nil {
+		return nil
+	}
+	return node.parent.value
+}
+
+// rootValue returns the value of the root node.
+func (node *valueNode) rootValue() interface{} {
+	if node == nil {
+		return nil
+	}
+	for node.parent != nil {
+		node = node.parent
+	}
+	return node.value
 }
 
 // ErrorCollector returns an ErrorCollector.
 func (v *Validator) ErrorCollector() ErrorCollector {
 	if v.errorCollector == nil {
-		v.errorCollector = v.errorCollectorFactoryFunc()
-
-		if v.errorCollector == nil {
-			panic("failed to create an ErrorCollector")
-		}
+		v.errorCollector = v.newErrorCollector(v.errorCollectorFactoryFunc())
 	}
 	return v.errorCollector
 }
 
+// newErrorCollector returns the ErrorCollector limited by the maximum number of errors.
+// The errorCollector should be created by the ErrorCollectorFactoryFunc.
+func (v *Validator) newErrorCollector(errorCollector ErrorCollector) ErrorCollector {
+	if errorCollector == nil {
+		panic("failed to create an ErrorCollector")
+	}
+	if v.maxErrors > 0 {
+		return newLimitedErrorCollector(errorCollector, v.maxErrors)
+	}
+	return errorCollector
+}
+
+// newRecordingErrorCollector returns a new ErrorCollector created by the ErrorCollectorFactoryFunc,
+// and the recordingErrorCollector that records the errors added to it.
+func (v *Validator) newRecordingErrorCollector() (ErrorCollector, *recordingErrorCollector) {
+	errorCollector := v.errorCollectorFactoryFunc()
+	if errorCollector == nil {
+		panic("failed to create an ErrorCollector")
+	}
+	recorder := &recordingErrorCollector{ErrorCollector: errorCollector, errors: make([]*LocationError, 0)}
+	return v.newErrorCollector(recorder), recorder
+}
+
 // Validate the value.
 // It returns an error if any rules are not met.
 func (v *Validator) Validate(value interface{}, rules ...Rule) error {
+	return v.ValidateContext(context.Background(), value, rules...)
+}
+
+// ValidateContext validates the value with the ctx.
+// The ctx can be obtained from the Validator in rules.
+//
+// When the ctx is done, the rules stop descending to the remaining values,
+// and it returns an error that includes the code.Canceled.
+func (v *Validator) ValidateContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	errorCollector := v.validate(ctx, value, rules...)
+	if errorCollector.HasError() {
+		return errorCollector.MakeError()
+	}
+	return nil
+}
+
+// ValidateWithWarnings is equiv to Validate, but it also returns the warnings.
+// The warnings is not nil when there are warnings, even if the err is nil. See also NewWarning.
+//
+// When the ErrorCollector does not implement WarningCollector, the warnings is always nil.
+func (v *Validator) ValidateWithWarnings(value interface{}, rules ...Rule) (warnings error, err error) {
+	return v.ValidateContextWithWarnings(context.Background(), value, rules...)
+}
+
+// ValidateContextWithWarnings is equiv to ValidateContext, but it also returns the warnings.
+// See also ValidateWithWarnings.
+func (v *Validator) ValidateContextWithWarnings(ctx context.Context, value interface{}, rules ...Rule) (warnings error, err error) {
+	errorCollector := v.validate(ctx, value, rules...)
+	if c, ok := errorCollector.(WarningCollector); ok && c.HasWarning() {
+		warnings = c.MakeWarning()
+	}
+	if errorCollector.HasError() {
+		err = errorCollector.MakeError()
+	}
+	return warnings, err
+}
+
+// validate verifies the value with the ctx, and returns the ErrorCollector that collected errors.
+func (v *Validator) validate(ctx context.Context, value interface{}, rules ...Rule) ErrorCollector {
 	newValidator := v.Clone(&CloneOpts{})
+	newValidator.ctx = ctx
+	newValidator.canceled = new(int32)
 	And(rules...).Validate(newValidator, value)
 
-	if newValidator.ErrorCollector().HasError() {
-		return newValidator.ErrorCollector().MakeError()
+	// NOTE: the cancellation is reported only when the rules stopped traversal by it.
+	if atomic.LoadInt32(newValidator.canceled) != 0 {
+		newValidator.ErrorCollector().Add(newValidator.Location(), NewError(code.Canceled, value, ctx.Err()))
 	}
-	return nil
+	return newValidator.ErrorCollector()
+}
+
+// isCanceled returns true, when the context is done.
+// It records the cancellation, because the rules stop traversal when it returns true.
+func (v *Validator) isCanceled() bool {
+	if v.ctx.Err() == nil {
+		return false
+	}
+	if v.canceled != nil {
+		atomic.StoreInt32(v.canceled, 1)
+	}
+	return true
+}
+
+// isFull returns true, when the ErrorCollector can not collect any more errors.
+func (v *Validator) isFull() bool {
+	if c, ok := v.ErrorCollector().(LimitedErrorCollector); ok {
+		return c.IsFull()
+	}
+	return false
+}
+
+// isStopped returns true, when the rules should stop traversal.
+func (v *Validator) isStopped() bool {
+	return v.isCanceled() || v.isFull()
 }
@@ -1,5 +1,7 @@
 package valis
 
+import "context"
+
 var (
 	standardValidator = NewValidator()
 )
@@ -10,6 +12,18 @@ func Validate(value interface{}, rules ...Rule) error {
 	return standardValidator.Clone(&CloneOpts{}).Validate(value, rules...)
 }
 
+// ValidateContext validates the value with the ctx using the StandardValidator.
+// See Validator.ValidateContext
+func ValidateContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	return standardValidator.Clone(&CloneOpts{}).ValidateContext(ctx, value, rules...)
+}
+
+// ValidateWithWarnings validates the value using the StandardValidator, and returns the warnings too.
+// See Validator.ValidateWithWarnings
+func ValidateWithWarnings(value interface{}, rules ...Rule) (warnings error, err error) {
+	return standardValidator.Clone(&CloneOpts{}).ValidateWithWarnings(value, rules...)
+}
+
 // AddCommonRules add the rules to common rules of the StandardValidator.
 // See Validator.AddCommonRules
 func AddCommonRules(rules ...Rule) {
@@ -21,3 +35,15 @@ func AddCommonRules(rules ...Rule) {
 func SetErrorCollectorFactoryFunc(f ErrorCollectorFactoryFunc) {
 	standardValidator.SetErrorCollectorFactoryFunc(f)
 }
+
+// SetMaxErrors is update the maximum number of errors of the StandardValidator.
+// See Validator.SetMaxErrors
+func SetMaxErrors(max int) {
+	standardValidator.SetMaxErrors(max)
+}
+
+// SetConcurrency is update the number of workers of the StandardValidator.
+// See Validator.SetConcurrency
+func SetConcurrency(n int) {
+	standardValidator.SetConcurrency(n)
+}
@@ -112,7 +112,7 @@ func Example_translate() {
 	// }
 	// {
 	//   ".Age": [
-	//     "は20より大きい値にする必要があります"
+	//     "は20以上の値にする必要があります"
 	//   ],
 	//   ".Name": [
 	//     "を空白にすることはできません"
@@ -186,6 +186,31 @@ func Example_nestedStruct() {
 	// (required) .Company.Location is required
 }
 
+func Example_deep() {
+	type Company struct {
+		Location *string `required:"true"`
+	}
+	type User struct {
+		Name      *string `required:"true"`
+		Age       int     `validate:"min=20"`
+		Companies []Company
+		Friend    *User
+	}
+
+	user := User{Companies: []Company{{}}}
+	user.Friend = &user
+	// Use Deep if you want to automatically search and validate all hierarchies.
+	if err := valis.Validate(&user, valis.Deep(tagrule.Required, tagrule.Validate)); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// (required) .Name is required
+	// (gte) .Age must be greater than or equal to 20
+	// (required) .Companies[0].Location is required
+	// (circular_reference) .Friend is a circular reference
+}
+
 func Example_flow() {
 	arr := []interface{}{0, 1, 2, 3, "a", "b", "c", "A", "B", "C"}
 
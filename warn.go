@@ -0,0 +1,13 @@
+package valis
+
+// Warn returns a new rule that reports the errors of the rules as warnings.
+// It is useful for soft limits (e.g. close to quota), because the warnings do not fail the validation.
+// See also Validator.ValidateWithWarnings.
+func Warn(rules ...Rule) Rule {
+	return &rewriteRule{
+		rewrite: func(detail *errorDetail) {
+			detail.severity = SeverityWarning
+		},
+		rules: rules,
+	}
+}
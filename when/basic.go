@@ -61,6 +61,18 @@ func IsNumeric(rules ...valis.Rule) *valis.WhenRule {
 	return valis.When(cond, rules...)
 }
 
+// IsString returns a valis.WhenRule that verifies the value meets the rules when the value is string.
+func IsString(rules ...valis.Rule) *valis.WhenRule {
+	cond := func(ctx *valis.WhenContext) bool {
+		val := reflect.ValueOf(ctx.Value())
+		for val.Kind() == reflect.Ptr {
+			val = val.Elem()
+		}
+		return val.Kind() == reflect.String
+	}
+	return valis.When(cond, rules...)
+}
+
 // IsNil returns a valis.WhenRule that verifies the value meets the rules when the value is nil.
 func IsNil(rules ...valis.Rule) *valis.WhenRule {
 	cond := func(ctx *valis.WhenContext) bool {